@@ -0,0 +1,406 @@
+package google
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The patterns gcloud writes to a generated .gcloudignore file. They are only
+// applied when the source directory doesn't contain a .gcloudignore of its own.
+var defaultCloudFunctionsIgnorePatterns = []string{
+	".gcloudignore",
+	".git",
+	".gitignore",
+	"node_modules",
+}
+
+// Every entry in the generated archive uses this modification time so the
+// archive only changes when the file contents do.
+var cloudFunctionsSourceArchiveModTime = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type gcloudIgnoreRule struct {
+	// pattern is matched against the whole slash separated path relative to
+	// the source directory.
+	pattern string
+	negate  bool
+	dirOnly bool
+}
+
+type gcloudIgnoreRules []gcloudIgnoreRule
+
+// parseGcloudIgnore parses .gcloudignore content, which uses the .gitignore
+// syntax plus the `#!include:<file>` directive. Included files are resolved
+// relative to dir.
+func parseGcloudIgnore(dir, content string) (gcloudIgnoreRules, error) {
+	var rules gcloudIgnoreRules
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.HasPrefix(line, "#!include:") {
+			included := strings.TrimSpace(strings.TrimPrefix(line, "#!include:"))
+			b, err := ioutil.ReadFile(filepath.Join(dir, included))
+			if err != nil {
+				return nil, fmt.Errorf("Error reading %q included from .gcloudignore: %s", included, err)
+			}
+			includedRules, err := parseGcloudIgnore(dir, string(b))
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, includedRules...)
+			continue
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rule := gcloudIgnoreRule{}
+		if strings.HasPrefix(line, "!") {
+			rule.negate = true
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			rule.dirOnly = true
+			line = strings.TrimSuffix(line, "/")
+		}
+		// As with .gitignore, a pattern without a slash matches at any depth, and
+		// any other pattern is relative to the source directory.
+		if strings.HasPrefix(line, "/") {
+			line = strings.TrimPrefix(line, "/")
+		} else if !strings.Contains(line, "/") {
+			line = "**/" + line
+		}
+		if line == "" || line == "**/" {
+			continue
+		}
+		rule.pattern = line
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func (r gcloudIgnoreRule) matches(relPath string, isDir bool) bool {
+	if r.dirOnly && !isDir {
+		return false
+	}
+	return matchGcloudIgnorePattern(strings.Split(r.pattern, "/"), strings.Split(relPath, "/"))
+}
+
+// matchGcloudIgnorePattern matches path segments against pattern segments,
+// where a "**" segment matches any number of path segments. A trailing "**"
+// matches everything inside a directory, but not the directory itself.
+func matchGcloudIgnorePattern(pattern, segments []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			if len(pattern) == 1 {
+				return len(segments) > 0
+			}
+			for i := 0; i <= len(segments); i++ {
+				if matchGcloudIgnorePattern(pattern[1:], segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segments) == 0 {
+			return false
+		}
+		if matched, _ := path.Match(pattern[0], segments[0]); !matched {
+			return false
+		}
+		pattern, segments = pattern[1:], segments[1:]
+	}
+	return len(segments) == 0
+}
+
+// ignored reports whether relPath (slash separated, relative to the source
+// directory) is excluded. As with .gitignore, the last matching rule wins.
+func (rules gcloudIgnoreRules) ignored(relPath string, isDir bool) bool {
+	ignored := false
+	for _, rule := range rules {
+		if rule.matches(relPath, isDir) {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
+
+func readCloudFunctionsIgnoreRules(dir string) (gcloudIgnoreRules, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, ".gcloudignore"))
+	if os.IsNotExist(err) {
+		return parseGcloudIgnore(dir, strings.Join(defaultCloudFunctionsIgnorePatterns, "\n"))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error reading .gcloudignore: %s", err)
+	}
+	return parseGcloudIgnore(dir, string(b))
+}
+
+// listCloudFunctionSourceFiles returns the slash separated paths, relative to
+// dir and in lexical order, of every file that will be deployed from dir.
+func listCloudFunctionSourceFiles(dir string) ([]string, error) {
+	rules, err := readCloudFunctionsIgnoreRules(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	err = filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+		if rules.ignored(rel, info.IsDir()) {
+			log.Printf("[DEBUG] Skipping %q ignored by .gcloudignore", rel)
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.Mode().IsRegular() {
+			files = append(files, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading source_dir %q: %s", dir, err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("source_dir %q doesn't contain any files to deploy", dir)
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+// cloudFunctionSourceDirHash returns a hash of the names, modes and contents
+// of the files deployed from dir.
+func cloudFunctionSourceDirHash(dir string) (string, error) {
+	_, hash, err := buildCloudFunctionSourceArchive(dir, false)
+	return hash, err
+}
+
+// zipCloudFunctionSourceDir builds a deterministic zip archive of the files
+// deployed from dir, returning it alongside the hash of its contents.
+func zipCloudFunctionSourceDir(dir string) ([]byte, string, error) {
+	return buildCloudFunctionSourceArchive(dir, true)
+}
+
+func buildCloudFunctionSourceArchive(dir string, writeArchive bool) ([]byte, string, error) {
+	files, err := listCloudFunctionSourceFiles(dir)
+	if err != nil {
+		return nil, "", err
+	}
+
+	h := sha256.New()
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+	for _, f := range files {
+		p := filepath.Join(dir, filepath.FromSlash(f))
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, "", err
+		}
+		content, err := ioutil.ReadFile(p)
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Only the executable bit is meaningful to the function's runtime.
+		mode := os.FileMode(0644)
+		if info.Mode()&0111 != 0 {
+			mode = 0755
+		}
+
+		fmt.Fprintf(h, "%s\x00%o\x00%d\x00", f, mode, len(content))
+		h.Write(content)
+
+		if !writeArchive {
+			continue
+		}
+		header := &zip.FileHeader{
+			Name:     f,
+			Method:   zip.Deflate,
+			Modified: cloudFunctionsSourceArchiveModTime,
+		}
+		header.SetMode(mode)
+		fw, err := w.CreateHeader(header)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := fw.Write(content); err != nil {
+			return nil, "", err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return buf.Bytes(), hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// uploadCloudFunctionSourceArchive uploads archive to a signed URL returned by
+// a generateUploadUrl call. The URL carries its own credentials, so the request
+// is sent without the provider's authentication.
+func uploadCloudFunctionSourceArchive(ctx context.Context, uploadUrl string, archive []byte, headers map[string]string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", uploadUrl, bytes.NewReader(archive))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/zip")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	res, err := cleanhttp.DefaultClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("Error uploading function source: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(res.Body)
+		return fmt.Errorf("Error uploading function source: %s: %s", res.Status, string(body))
+	}
+	return nil
+}
+
+// cloudFunctionsSourceDirCustomizeDiff tracks the contents of source_dir in
+// source_code_hash, so a function is only redeployed when the files change.
+func cloudFunctionsSourceDirCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("source_dir") {
+		return diff.SetNewComputed("source_code_hash")
+	}
+
+	dir := diff.Get("source_dir").(string)
+	if dir == "" {
+		if diff.Get("source_code_hash").(string) != "" {
+			return diff.SetNew("source_code_hash", "")
+		}
+		return nil
+	}
+
+	hash, err := cloudFunctionSourceDirHash(dir)
+	if err != nil {
+		return err
+	}
+	if hash != diff.Get("source_code_hash").(string) {
+		return diff.SetNew("source_code_hash", hash)
+	}
+	return nil
+}
+
+// The encoders of google_cloudfunctions2_function upload source_dir before
+// the function is created or updated.
+
+func resourceCloudfunctions2functionEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	if _, ok := d.GetOk("source_dir"); !ok {
+		return obj, nil
+	}
+	return cloudfunctions2functionUploadSourceDir(d, meta, obj, d.Timeout(schema.TimeoutCreate))
+}
+
+func resourceCloudfunctions2functionUpdateEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	if _, ok := d.GetOk("source_dir"); !ok {
+		return obj, nil
+	}
+	// Any change to build_config sends the whole buildConfig in the update mask,
+	// which must include the source. The uploaded source isn't kept in state,
+	// so upload source_dir again.
+	if !(d.HasChange("source_dir") || d.HasChange("source_code_hash") || d.HasChange("build_config")) {
+		return obj, nil
+	}
+	return cloudfunctions2functionUploadSourceDir(d, meta, obj, d.Timeout(schema.TimeoutUpdate))
+}
+
+// cloudfunctions2functionUploadSourceDir zips source_dir, uploads it through
+// generateUploadUrl and points the function's build at the uploaded object.
+func cloudfunctions2functionUploadSourceDir(d *schema.ResourceData, meta interface{}, obj map[string]interface{}, timeout time.Duration) (map[string]interface{}, error) {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, hash, err := zipCloudFunctionSourceDir(d.Get("source_dir").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := replaceVars(d, config, "{{Cloudfunctions2BasePath}}projects/{{project}}/locations/{{location}}/functions:generateUploadUrl")
+	if err != nil {
+		return nil, err
+	}
+
+	billingProject := ""
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, fmt.Errorf("Error fetching project for function: %s", err)
+	}
+	billingProject = project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", billingProject, url, userAgent, map[string]interface{}{}, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("Error generating upload URL for function: %s", err)
+	}
+
+	uploadUrl, ok := res["uploadUrl"].(string)
+	if !ok || uploadUrl == "" {
+		return nil, fmt.Errorf("Error generating upload URL for function: no uploadUrl in response %#v", res)
+	}
+	storageSource, ok := res["storageSource"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Error generating upload URL for function: no storageSource in response %#v", res)
+	}
+
+	log.Printf("[DEBUG] Uploading %d byte source archive for function %q", len(archive), d.Get("name").(string))
+	if err := uploadCloudFunctionSourceArchive(config.context, uploadUrl, archive, nil, timeout); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("source_code_hash", hash); err != nil {
+		return nil, fmt.Errorf("Error setting source_code_hash: %s", err)
+	}
+
+	buildConfig, ok := obj["buildConfig"].(map[string]interface{})
+	if !ok {
+		buildConfig = make(map[string]interface{})
+	}
+	buildConfig["source"] = map[string]interface{}{
+		"storageSource": storageSource,
+	}
+	obj["buildConfig"] = buildConfig
+
+	return obj, nil
+}
@@ -0,0 +1,178 @@
+package google
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeCloudFunctionSourceFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestGcloudIgnoreRules(t *testing.T) {
+	t.Parallel()
+
+	rules, err := parseGcloudIgnore("", `
+# comment
+*.pyc
+/build
+logs/
+docs/*.md
+!docs/README.md
+**/tmp
+node_modules/**/test
+cache/**
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		Path     string
+		IsDir    bool
+		Expected bool
+	}{
+		"basename glob":            {Path: "pkg/mod.pyc", Expected: true},
+		"basename glob no match":   {Path: "pkg/mod.py", Expected: false},
+		"anchored at root":         {Path: "build", IsDir: true, Expected: true},
+		"anchored not at root":     {Path: "src/build", IsDir: true, Expected: false},
+		"dir only matches dir":     {Path: "src/logs", IsDir: true, Expected: true},
+		"dir only skips file":      {Path: "src/logs", Expected: false},
+		"pattern with slash":       {Path: "docs/guide.md", Expected: true},
+		"negated pattern":          {Path: "docs/README.md", Expected: false},
+		"leading double star":      {Path: "a/b/tmp", IsDir: true, Expected: true},
+		"leading double star root": {Path: "tmp", Expected: true},
+		"inner double star":        {Path: "node_modules/a/b/test", IsDir: true, Expected: true},
+		"inner double star empty":  {Path: "node_modules/test", IsDir: true, Expected: true},
+		"inner double star prefix": {Path: "src/node_modules/a/test", IsDir: true, Expected: false},
+		"trailing double star":     {Path: "cache/a/b.txt", Expected: true},
+		"trailing double star dir": {Path: "cache", IsDir: true, Expected: false},
+		"comment is not a pattern": {Path: "# comment", Expected: false},
+	}
+
+	for tn, tc := range cases {
+		if got := rules.ignored(tc.Path, tc.IsDir); got != tc.Expected {
+			t.Errorf("bad: %s, %q expected ignored to be %v, got %v", tn, tc.Path, tc.Expected, got)
+		}
+	}
+}
+
+func TestListCloudFunctionSourceFiles(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "tf-test-source-dir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeCloudFunctionSourceFiles(t, dir, map[string]string{
+		"index.js":                  "exports.hello = () => {}",
+		"package.json":              "{}",
+		"lib/util.js":               "",
+		"node_modules/dep/index.js": "",
+		".git/HEAD":                 "ref: refs/heads/main",
+	})
+
+	files, err := listCloudFunctionSourceFiles(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"index.js", "lib/util.js", "package.json"}
+	if !reflect.DeepEqual(files, expected) {
+		t.Errorf("expected default ignores to produce %v, got %v", expected, files)
+	}
+
+	writeCloudFunctionSourceFiles(t, dir, map[string]string{
+		".gcloudignore": "#!include:.gitignore\nlib/\n",
+		".gitignore":    "package.json\n",
+	})
+
+	files, err = listCloudFunctionSourceFiles(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []string{".gcloudignore", ".git/HEAD", ".gitignore", "index.js", "node_modules/dep/index.js"}
+	if !reflect.DeepEqual(files, expected) {
+		t.Errorf("expected .gcloudignore to produce %v, got %v", expected, files)
+	}
+}
+
+func TestZipCloudFunctionSourceDir(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "tf-test-source-dir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeCloudFunctionSourceFiles(t, dir, map[string]string{
+		"main.py":          "def hello(request):\n  return 'hi'\n",
+		"requirements.txt": "",
+	})
+
+	archive, hash, err := zipCloudFunctionSourceDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Rewriting a file with the same content mustn't change the archive or hash
+	writeCloudFunctionSourceFiles(t, dir, map[string]string{
+		"main.py": "def hello(request):\n  return 'hi'\n",
+	})
+	archive2, hash2, err := zipCloudFunctionSourceDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(archive, archive2) {
+		t.Errorf("expected the archive to be deterministic")
+	}
+	if hash != hash2 {
+		t.Errorf("expected hash %q to be unchanged, got %q", hash, hash2)
+	}
+
+	onlyHash, err := cloudFunctionSourceDirHash(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if onlyHash != hash {
+		t.Errorf("expected hash %q to match the archive's hash, got %q", hash, onlyHash)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+	if expected := []string{"main.py", "requirements.txt"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected archive to contain %v, got %v", expected, names)
+	}
+
+	writeCloudFunctionSourceFiles(t, dir, map[string]string{
+		"main.py": "def hello(request):\n  return 'hello'\n",
+	})
+	changedHash, err := cloudFunctionSourceDirHash(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changedHash == hash {
+		t.Errorf("expected hash to change with the file contents")
+	}
+}
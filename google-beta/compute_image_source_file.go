@@ -0,0 +1,166 @@
+package google
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/storage/v1"
+)
+
+// The compute API only accepts raw disks packaged as a gzipped tarball that
+// contains a single file named disk.raw.
+const computeImageRawDiskFileName = "disk.raw"
+
+func computeImageSourceFileSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: `Path to a local disk file. Files ending in .tar.gz or .tgz are uploaded as-is and
+must already contain a disk.raw file, any other file is packaged as disk.raw into a tar.gz archive.`,
+			},
+			"staging_bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Name of the Cloud Storage bucket the archive is uploaded to while the image is created. The staging object is deleted afterwards.`,
+			},
+			"content_hash": {
+				Type: schema.TypeString,
+				// This field is not Computed because it needs to trigger a diff when the local file changes.
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "different hash",
+				DiffSuppressFunc: computeImageSourceFileContentHashDiffSuppress,
+				Description:      `SHA256 hash of the local file, used to detect changes to it.`,
+			},
+		},
+	}
+}
+
+func computeImageSourceFileContentHashDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	path, ok := d.GetOk("source_file.0.path")
+	if !ok {
+		return false
+	}
+
+	localHash := getFileSha256Hash(path.(string))
+	// If the file can't be read there is nothing to compare against, keep the diff.
+	if localHash == "" {
+		return false
+	}
+
+	return old == localHash
+}
+
+func getFileSha256Hash(filename string) string {
+	f, err := os.Open(filename)
+	if err != nil {
+		log.Printf("[WARN] Failed to read source file %q. Cannot compute sha256 hash for it.", filename)
+		return ""
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		log.Printf("[WARN] Failed to compute sha256 hash for source file %q: %v", filename, err)
+		return ""
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func isComputeImageArchive(filename string) bool {
+	return strings.HasSuffix(filename, ".tar.gz") || strings.HasSuffix(filename, ".tgz")
+}
+
+// writeComputeImageArchive writes the contents of the local raw disk f to w as a
+// tar.gz archive holding a single disk.raw entry.
+func writeComputeImageArchive(w io.Writer, f *os.File) error {
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	hdr := &tar.Header{
+		Name:    computeImageRawDiskFileName,
+		Mode:    0644,
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+		Format:  tar.FormatGNU,
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if _, err := io.Copy(tw, f); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// stageComputeImageSourceFile uploads the configured local file to the staging
+// bucket, packaging it first if needed. It returns the rawDisk value pointing at
+// the staged archive, the content hash of the local file and a func that removes
+// the staging object again.
+func stageComputeImageSourceFile(d *schema.ResourceData, config *Config, userAgent string) (map[string]interface{}, string, func(), error) {
+	path := d.Get("source_file.0.path").(string)
+	bucket := d.Get("source_file.0.staging_bucket").(string)
+
+	hash := getFileSha256Hash(path)
+	if hash == "" {
+		return nil, "", nil, fmt.Errorf("Error reading source_file %q for Image", path)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, "", nil, fmt.Errorf("Error opening source_file %q for Image: %s", path, err)
+	}
+	defer f.Close()
+
+	var media io.Reader = f
+	if !isComputeImageArchive(path) {
+		pr, pw := io.Pipe()
+		go func() {
+			pw.CloseWithError(writeComputeImageArchive(pw, f))
+		}()
+		defer pr.Close()
+		media = pr
+	}
+
+	objectName := fmt.Sprintf("terraform-image-staging/%s-%s.tar.gz", d.Get("name").(string), hash[:16])
+	objectsService := storage.NewObjectsService(config.NewStorageClientWithTimeoutOverride(userAgent, d.Timeout(schema.TimeoutCreate)))
+
+	log.Printf("[DEBUG] Uploading source_file %q for Image to gs://%s/%s", path, bucket, objectName)
+	insertCall := objectsService.Insert(bucket, &storage.Object{Name: objectName})
+	insertCall.Name(objectName)
+	insertCall.Media(media)
+	if _, err := insertCall.Do(); err != nil {
+		return nil, "", nil, fmt.Errorf("Error uploading source_file %q to bucket %q: %s", path, bucket, err)
+	}
+
+	cleanup := func() {
+		if err := objectsService.Delete(bucket, objectName).Do(); err != nil {
+			log.Printf("[WARN] Failed to delete staging object gs://%s/%s for Image: %s", bucket, objectName, err)
+		}
+	}
+
+	rawDisk := map[string]interface{}{
+		"source":        fmt.Sprintf("https://storage.googleapis.com/%s/%s", bucket, objectName),
+		"containerType": "TAR",
+	}
+	return rawDisk, hash, cleanup, nil
+}
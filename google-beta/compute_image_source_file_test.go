@@ -0,0 +1,85 @@
+package google
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestIsComputeImageArchive(t *testing.T) {
+	cases := map[string]bool{
+		"disk.raw":          false,
+		"disk.vmdk":         false,
+		"image.tar.gz":      true,
+		"image.tgz":         true,
+		"/tmp/image.tar":    false,
+		"/tmp/tar.gz/disk":  false,
+		"/tmp/disk.raw.tgz": true,
+	}
+
+	for filename, expected := range cases {
+		if got := isComputeImageArchive(filename); got != expected {
+			t.Errorf("isComputeImageArchive(%q) = %t, expected %t", filename, got, expected)
+		}
+	}
+}
+
+func TestWriteComputeImageArchive(t *testing.T) {
+	content := []byte("not really a disk")
+	f := getNewTmpTestFile(t, "tf-test-disk")
+	defer os.Remove(f.Name())
+	if _, err := f.Write(content); err != nil {
+		t.Fatalf("Cannot write temp file: %s", err)
+	}
+	if _, err := f.Seek(0, 0); err != nil {
+		t.Fatalf("Cannot rewind temp file: %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := writeComputeImageArchive(&buf, f); err != nil {
+		t.Fatalf("Error writing archive: %s", err)
+	}
+
+	gr, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatalf("Archive is not gzipped: %s", err)
+	}
+	tr := tar.NewReader(gr)
+	hdr, err := tr.Next()
+	if err != nil {
+		t.Fatalf("Archive is not a tarball: %s", err)
+	}
+	if hdr.Name != "disk.raw" {
+		t.Errorf("Expected archive entry disk.raw, got %q", hdr.Name)
+	}
+	got, err := ioutil.ReadAll(tr)
+	if err != nil {
+		t.Fatalf("Error reading archive entry: %s", err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Errorf("Expected archive entry content %q, got %q", content, got)
+	}
+	if _, err := tr.Next(); err == nil {
+		t.Errorf("Expected a single archive entry")
+	}
+}
+
+func TestGetFileSha256Hash(t *testing.T) {
+	f := getNewTmpTestFile(t, "tf-test-disk")
+	defer os.Remove(f.Name())
+	if _, err := f.Write([]byte("hello")); err != nil {
+		t.Fatalf("Cannot write temp file: %s", err)
+	}
+	f.Close()
+
+	expected := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	if got := getFileSha256Hash(f.Name()); got != expected {
+		t.Errorf("Expected hash %s, got %s", expected, got)
+	}
+	if got := getFileSha256Hash(f.Name() + "-missing"); got != "" {
+		t.Errorf("Expected empty hash for a missing file, got %s", got)
+	}
+}
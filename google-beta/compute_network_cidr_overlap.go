@@ -0,0 +1,394 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/apparentlymart/go-cidr/cidr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	compute "google.golang.org/api/compute/v0.beta"
+)
+
+// networkCidrReservation is an IP range already in use within a VPC network.
+type networkCidrReservation struct {
+	cidr *net.IPNet
+	// kind and name identify the resource owning the range in diagnostics,
+	// e.g. subnetwork "projects/my-project/regions/us-central1/subnetworks/my-subnet"
+	kind  string
+	name  string
+	field string
+}
+
+func (r networkCidrReservation) String() string {
+	return fmt.Sprintf("%s %q (%s %s)", r.kind, r.name, r.field, r.cidr)
+}
+
+// networkCidrCandidate is an IP range a resource is planning to use.
+type networkCidrCandidate struct {
+	field string
+	cidr  string
+}
+
+// networkCidrReservationsLoader is called with the VPC network being checked,
+// and is replaced in unit tests.
+type networkCidrReservationsLoader func(project, network, region string) ([]networkCidrReservation, error)
+
+func parseNetworkCidr(field, v string) (*net.IPNet, error) {
+	ip, ipnet, err := net.ParseCIDR(v)
+	if err != nil {
+		return nil, fmt.Errorf("%s %q is not a valid CIDR range: %s", field, v, err)
+	}
+	if !ip.Equal(ipnet.IP) {
+		return nil, fmt.Errorf("%s %q is not the first address of its range, did you mean %q?", field, v, ipnet.String())
+	}
+	return ipnet, nil
+}
+
+func networkCidrsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// checkNetworkCidrOverlaps returns an error naming the conflicting resource
+// when a candidate range overlaps another candidate or an existing range.
+// Existing ranges owned by the resource being planned, identified by
+// selfName, are skipped.
+func checkNetworkCidrOverlaps(candidates []networkCidrCandidate, existing []networkCidrReservation, selfName string) error {
+	var planned []networkCidrReservation
+	for _, c := range candidates {
+		ipnet, err := parseNetworkCidr(c.field, c.cidr)
+		if err != nil {
+			return err
+		}
+
+		for _, p := range planned {
+			if networkCidrsOverlap(ipnet, p.cidr) {
+				return fmt.Errorf("%s %q overlaps with %s %s of this resource", c.field, c.cidr, p.field, p.cidr)
+			}
+		}
+		for _, r := range existing {
+			if selfName != "" && r.name == selfName {
+				continue
+			}
+			if networkCidrsOverlap(ipnet, r.cidr) {
+				return fmt.Errorf("%s %q overlaps with the range already used by %s", c.field, c.cidr, r)
+			}
+		}
+
+		planned = append(planned, networkCidrReservation{cidr: ipnet, field: c.field})
+	}
+	return nil
+}
+
+// loadNetworkCidrReservations lists the ranges in use within a network: the
+// primary and secondary ranges of its subnetworks, the ranges reserved for
+// private services access and the subnet routes imported from peered networks.
+func loadNetworkCidrReservations(config *Config, userAgent, project, network, region string) ([]networkCidrReservation, error) {
+	var reservations []networkCidrReservation
+	add := func(kind, name, field, v string) {
+		_, ipnet, err := net.ParseCIDR(v)
+		if err != nil {
+			log.Printf("[DEBUG] Ignoring unparseable range %q of %s %q", v, kind, name)
+			return
+		}
+		reservations = append(reservations, networkCidrReservation{cidr: ipnet, kind: kind, name: name, field: field})
+	}
+
+	computeClient := config.NewComputeClient(userAgent)
+	vpc, err := computeClient.Networks.Get(project, network).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	err = computeClient.Subnetworks.AggregatedList(project).Pages(context.Background(), func(page *compute.SubnetworkAggregatedList) error {
+		for _, scoped := range page.Items {
+			for _, s := range scoped.Subnetworks {
+				if !compareSelfLinkOrResourceName("", s.Network, vpc.SelfLink, nil) {
+					continue
+				}
+				name := fmt.Sprintf("projects/%s/regions/%s/subnetworks/%s", project, GetResourceNameFromSelfLink(s.Region), s.Name)
+				add("subnetwork", name, "ip_cidr_range", s.IpCidrRange)
+				for _, r := range s.SecondaryIpRanges {
+					add("subnetwork", name, fmt.Sprintf("secondary_ip_range %q", r.RangeName), r.IpCidrRange)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = computeClient.GlobalAddresses.List(project).Pages(context.Background(), func(page *compute.AddressList) error {
+		for _, a := range page.Items {
+			if a.Purpose != "VPC_PEERING" || a.Network == "" || !compareSelfLinkOrResourceName("", a.Network, vpc.SelfLink, nil) {
+				continue
+			}
+			name := fmt.Sprintf("projects/%s/global/addresses/%s", project, a.Name)
+			add("global address", name, "address", fmt.Sprintf("%s/%d", a.Address, a.PrefixLength))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if region == "" {
+		return reservations, nil
+	}
+	for _, peering := range vpc.Peerings {
+		if peering.State != "ACTIVE" {
+			continue
+		}
+		err = computeClient.Networks.ListPeeringRoutes(project, network).Direction("INCOMING").PeeringName(peering.Name).Region(region).Pages(context.Background(), func(page *compute.ExchangedPeeringRoutesList) error {
+			for _, r := range page.Items {
+				add("peering", peering.Name, "imported route", r.DestRange)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return reservations, nil
+}
+
+func networkCidrReservationsLoaderFromConfig(config *Config) networkCidrReservationsLoader {
+	return func(project, network, region string) ([]networkCidrReservation, error) {
+		return loadNetworkCidrReservations(config, config.userAgent, project, network, region)
+	}
+}
+
+// resolveNetworkFromDiff returns the project and name of the network named by
+// the given field, which may be a name or a self link.
+func resolveNetworkFromDiff(d *schema.ResourceDiff, field string, config *Config) (string, string, error) {
+	network := d.Get(field).(string)
+	if parts := regexp.MustCompile(fmt.Sprintf(globalLinkBasePattern, "networks")).FindStringSubmatch(network); parts != nil {
+		return parts[1], parts[2], nil
+	}
+	project, err := getProjectFromDiff(d, config)
+	if err != nil {
+		return "", "", err
+	}
+	return project, GetResourceNameFromSelfLink(network), nil
+}
+
+// loadNetworkCidrReservationsAtPlan returns the ranges in use in the network
+// named by networkField. Nothing is loaded unless the provider is configured
+// with cidr_overlap_check, or while the network is unknown or doesn't exist yet.
+func loadNetworkCidrReservationsAtPlan(d *schema.ResourceDiff, config *Config, networkField, region string, load networkCidrReservationsLoader) ([]networkCidrReservation, error) {
+	if !config.cidrOverlapCheck {
+		return nil, nil
+	}
+	if !d.NewValueKnown(networkField) || d.Get(networkField).(string) == "" {
+		return nil, nil
+	}
+
+	project, network, err := resolveNetworkFromDiff(d, networkField, config)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := load(project, network, region)
+	if err != nil {
+		if isGoogleApiErrorWithCode(err, 404) {
+			log.Printf("[DEBUG] Network %q not found, skipping CIDR overlap checks", network)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error loading the CIDR ranges used in network %q, unset cidr_overlap_check in the provider to skip this check: %s", network, err)
+	}
+	return existing, nil
+}
+
+// checkNetworkCidrsAtPlan validates candidates against each other, and against
+// the ranges in use in the network named by networkField when the provider is
+// configured with cidr_overlap_check.
+func checkNetworkCidrsAtPlan(d *schema.ResourceDiff, config *Config, networkField, region, selfName string, candidates []networkCidrCandidate, load networkCidrReservationsLoader) error {
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	existing, err := loadNetworkCidrReservationsAtPlan(d, config, networkField, region, load)
+	if err != nil {
+		return err
+	}
+
+	return checkNetworkCidrOverlaps(candidates, existing, selfName)
+}
+
+// checkSubnetworkSecondaryRangeNames returns an error when a range name set in
+// one of fields isn't a secondary range of the subnetwork named subnetworkName
+// in existing. Nothing is checked when the subnetwork isn't in existing, e.g.
+// because it is created in the same run.
+func checkSubnetworkSecondaryRangeNames(existing []networkCidrReservation, subnetworkName string, fields map[string]string) error {
+	found := false
+	rangeNames := make(map[string]bool)
+	for _, r := range existing {
+		if r.kind != "subnetwork" || r.name != subnetworkName {
+			continue
+		}
+		found = true
+		rangeNames[r.field] = true
+	}
+	if !found {
+		return nil
+	}
+
+	for field, rangeName := range fields {
+		if rangeName == "" {
+			continue
+		}
+		if !rangeNames[fmt.Sprintf("secondary_ip_range %q", rangeName)] {
+			return fmt.Errorf("%s %q is not a secondary range of subnetwork %q", field, rangeName, subnetworkName)
+		}
+	}
+	return nil
+}
+
+// appendKnownCidrCandidate adds the value of field to candidates when it is a
+// known, complete CIDR range. Netmask-only values like "/14" are left for the
+// API to allocate.
+func appendKnownCidrCandidate(d *schema.ResourceDiff, candidates []networkCidrCandidate, field string) []networkCidrCandidate {
+	if !d.NewValueKnown(field) {
+		return candidates
+	}
+	v, ok := d.Get(field).(string)
+	if !ok || v == "" || strings.HasPrefix(v, "/") {
+		return candidates
+	}
+	return append(candidates, networkCidrCandidate{field: field, cidr: v})
+}
+
+func resourceComputeSubnetworkCidrOverlapCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("ip_cidr_range") && !d.HasChange("secondary_ip_range") {
+		return nil
+	}
+	config := meta.(*Config)
+
+	candidates := appendKnownCidrCandidate(d, nil, "ip_cidr_range")
+	if d.NewValueKnown("secondary_ip_range") {
+		for i := range d.Get("secondary_ip_range").([]interface{}) {
+			candidates = appendKnownCidrCandidate(d, candidates, fmt.Sprintf("secondary_ip_range.%d.ip_cidr_range", i))
+		}
+	}
+
+	project, err := getProjectFromDiff(d, config)
+	if err != nil {
+		return err
+	}
+	region := d.Get("region").(string)
+	if region == "" {
+		region = config.Region
+	}
+	// The subnetwork's current ranges are skipped, as they're released when a
+	// shrinking range forces it to be recreated.
+	selfName := fmt.Sprintf("projects/%s/regions/%s/subnetworks/%s", project, region, d.Get("name").(string))
+
+	return checkNetworkCidrsAtPlan(d, config, "network", region, selfName, candidates, networkCidrReservationsLoaderFromConfig(config))
+}
+
+func resourceComputeGlobalAddressCidrOverlapCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("purpose").(string) != "VPC_PEERING" || !d.HasChanges("address", "prefix_length") {
+		return nil
+	}
+	if !d.NewValueKnown("address") || !d.NewValueKnown("prefix_length") || d.Get("address").(string) == "" {
+		// The API allocates the range
+		return nil
+	}
+	config := meta.(*Config)
+
+	cidrRange := fmt.Sprintf("%s/%d", d.Get("address").(string), d.Get("prefix_length").(int))
+	candidates := []networkCidrCandidate{{field: "address", cidr: cidrRange}}
+
+	project, err := getProjectFromDiff(d, config)
+	if err != nil {
+		return err
+	}
+	selfName := fmt.Sprintf("projects/%s/global/addresses/%s", project, d.Get("name").(string))
+
+	return checkNetworkCidrsAtPlan(d, config, "network", config.Region, selfName, candidates, networkCidrReservationsLoaderFromConfig(config))
+}
+
+func resourceVPCAccessConnectorCidrOverlapCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("ip_cidr_range") {
+		return nil
+	}
+	candidates := appendKnownCidrCandidate(d, nil, "ip_cidr_range")
+	if len(candidates) == 0 {
+		return nil
+	}
+	if _, ipnet, err := net.ParseCIDR(candidates[0].cidr); err == nil {
+		if ones, _ := ipnet.Mask.Size(); ones != 28 {
+			return fmt.Errorf("ip_cidr_range %q must be a /28 range, it provides %d addresses instead of 16", candidates[0].cidr, cidr.AddressCount(ipnet))
+		}
+	}
+	config := meta.(*Config)
+
+	region := d.Get("region").(string)
+	if region == "" {
+		region = config.Region
+	}
+
+	return checkNetworkCidrsAtPlan(d, config, "network", region, "", candidates, networkCidrReservationsLoaderFromConfig(config))
+}
+
+// containerClusterCidrOverlapCustomizeDiff only checks new clusters, as the
+// ranges GKE allocates for an existing cluster are attributed to it in the
+// network under names that can't be matched back to the cluster.
+func containerClusterCidrOverlapCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+	config := meta.(*Config)
+
+	var candidates []networkCidrCandidate
+	candidates = appendKnownCidrCandidate(d, candidates, "ip_allocation_policy.0.cluster_ipv4_cidr_block")
+	candidates = appendKnownCidrCandidate(d, candidates, "ip_allocation_policy.0.services_ipv4_cidr_block")
+	candidates = appendKnownCidrCandidate(d, candidates, "private_cluster_config.0.master_ipv4_cidr_block")
+
+	secondaryRangeNames := make(map[string]string)
+	for _, field := range []string{"ip_allocation_policy.0.cluster_secondary_range_name", "ip_allocation_policy.0.services_secondary_range_name"} {
+		if d.NewValueKnown(field) {
+			secondaryRangeNames[field] = d.Get(field).(string)
+		}
+	}
+
+	region := ""
+	if location := d.Get("location").(string); location != "" {
+		if isZone(location) {
+			region = getRegionFromZone(location)
+		} else {
+			region = location
+		}
+	}
+
+	existing, err := loadNetworkCidrReservationsAtPlan(d, config, "network", region, networkCidrReservationsLoaderFromConfig(config))
+	if err != nil {
+		return err
+	}
+	if err := checkNetworkCidrOverlaps(candidates, existing, ""); err != nil {
+		return err
+	}
+
+	// The secondary ranges used for pods and services must be defined on the
+	// cluster's subnetwork
+	if region == "" || !d.NewValueKnown("subnetwork") || d.Get("subnetwork").(string) == "" {
+		return nil
+	}
+	subnetwork := d.Get("subnetwork").(string)
+	var subnetworkName string
+	if parts := regexp.MustCompile(fmt.Sprintf(regionalLinkBasePattern, "subnetworks")).FindStringSubmatch(subnetwork); parts != nil {
+		subnetworkName = fmt.Sprintf("projects/%s/regions/%s/subnetworks/%s", parts[1], parts[2], parts[3])
+	} else {
+		project, err := getProjectFromDiff(d, config)
+		if err != nil {
+			return err
+		}
+		subnetworkName = fmt.Sprintf("projects/%s/regions/%s/subnetworks/%s", project, region, subnetwork)
+	}
+	return checkSubnetworkSecondaryRangeNames(existing, subnetworkName, secondaryRangeNames)
+}
@@ -0,0 +1,145 @@
+package google
+
+import (
+	"net"
+	"strings"
+	"testing"
+)
+
+func testNetworkCidrReservation(t *testing.T, kind, name, field, v string) networkCidrReservation {
+	_, ipnet, err := net.ParseCIDR(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return networkCidrReservation{cidr: ipnet, kind: kind, name: name, field: field}
+}
+
+func TestCheckNetworkCidrOverlaps(t *testing.T) {
+	t.Parallel()
+
+	existing := []networkCidrReservation{
+		testNetworkCidrReservation(t, "subnetwork", "projects/p/regions/us-central1/subnetworks/a", "ip_cidr_range", "10.0.0.0/20"),
+		testNetworkCidrReservation(t, "subnetwork", "projects/p/regions/us-central1/subnetworks/a", `secondary_ip_range "pods"`, "10.4.0.0/14"),
+		testNetworkCidrReservation(t, "global address", "projects/p/global/addresses/psa", "address", "10.100.0.0/16"),
+		testNetworkCidrReservation(t, "peering", "peer", "imported route", "172.16.0.0/24"),
+	}
+
+	cases := map[string]struct {
+		Candidates    []networkCidrCandidate
+		SelfName      string
+		ExpectedError string
+	}{
+		"no overlap": {
+			Candidates: []networkCidrCandidate{{field: "ip_cidr_range", cidr: "10.1.0.0/20"}},
+		},
+		"overlaps subnetwork": {
+			Candidates:    []networkCidrCandidate{{field: "ip_cidr_range", cidr: "10.0.8.0/24"}},
+			ExpectedError: `subnetwork "projects/p/regions/us-central1/subnetworks/a" (ip_cidr_range 10.0.0.0/20)`,
+		},
+		"contains secondary range": {
+			Candidates:    []networkCidrCandidate{{field: "ip_allocation_policy.0.cluster_ipv4_cidr_block", cidr: "10.0.0.0/8"}},
+			ExpectedError: "overlaps with the range already used by subnetwork",
+		},
+		"overlaps private services access": {
+			Candidates:    []networkCidrCandidate{{field: "ip_cidr_range", cidr: "10.100.16.0/28"}},
+			ExpectedError: `global address "projects/p/global/addresses/psa"`,
+		},
+		"overlaps peering route": {
+			Candidates:    []networkCidrCandidate{{field: "private_cluster_config.0.master_ipv4_cidr_block", cidr: "172.16.0.0/28"}},
+			ExpectedError: `peering "peer" (imported route 172.16.0.0/24)`,
+		},
+		"own ranges are skipped": {
+			Candidates: []networkCidrCandidate{{field: "ip_cidr_range", cidr: "10.0.0.0/19"}},
+			SelfName:   "projects/p/regions/us-central1/subnetworks/a",
+		},
+		"candidates overlap each other": {
+			Candidates: []networkCidrCandidate{
+				{field: "ip_cidr_range", cidr: "10.20.0.0/16"},
+				{field: "secondary_ip_range.0.ip_cidr_range", cidr: "10.20.128.0/17"},
+			},
+			ExpectedError: "overlaps with ip_cidr_range 10.20.0.0/16 of this resource",
+		},
+		"not the first address": {
+			Candidates:    []networkCidrCandidate{{field: "ip_cidr_range", cidr: "10.30.0.1/24"}},
+			ExpectedError: `did you mean "10.30.0.0/24"?`,
+		},
+		"invalid range": {
+			Candidates:    []networkCidrCandidate{{field: "ip_cidr_range", cidr: "10.30.0.0/33"}},
+			ExpectedError: "is not a valid CIDR range",
+		},
+	}
+
+	for tn, tc := range cases {
+		err := checkNetworkCidrOverlaps(tc.Candidates, existing, tc.SelfName)
+		if tc.ExpectedError == "" {
+			if err != nil {
+				t.Errorf("bad: %s, expected no error, got %s", tn, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("bad: %s, expected an error containing %q", tn, tc.ExpectedError)
+			continue
+		}
+		if !strings.Contains(err.Error(), tc.ExpectedError) {
+			t.Errorf("bad: %s, expected an error containing %q, got %s", tn, tc.ExpectedError, err)
+		}
+	}
+}
+
+func TestCheckSubnetworkSecondaryRangeNames(t *testing.T) {
+	t.Parallel()
+
+	subnetwork := "projects/p/regions/us-central1/subnetworks/a"
+	existing := []networkCidrReservation{
+		testNetworkCidrReservation(t, "subnetwork", subnetwork, "ip_cidr_range", "10.0.0.0/20"),
+		testNetworkCidrReservation(t, "subnetwork", subnetwork, `secondary_ip_range "pods"`, "10.4.0.0/14"),
+		testNetworkCidrReservation(t, "subnetwork", subnetwork, `secondary_ip_range "services"`, "10.8.0.0/20"),
+	}
+
+	cases := map[string]struct {
+		Subnetwork    string
+		Fields        map[string]string
+		ExpectedError string
+	}{
+		"defined ranges": {
+			Subnetwork: subnetwork,
+			Fields: map[string]string{
+				"ip_allocation_policy.0.cluster_secondary_range_name":  "pods",
+				"ip_allocation_policy.0.services_secondary_range_name": "services",
+			},
+		},
+		"unset ranges": {
+			Subnetwork: subnetwork,
+			Fields: map[string]string{
+				"ip_allocation_policy.0.cluster_secondary_range_name": "",
+			},
+		},
+		"undefined range": {
+			Subnetwork: subnetwork,
+			Fields: map[string]string{
+				"ip_allocation_policy.0.cluster_secondary_range_name": "nodes",
+			},
+			ExpectedError: `ip_allocation_policy.0.cluster_secondary_range_name "nodes" is not a secondary range of subnetwork "projects/p/regions/us-central1/subnetworks/a"`,
+		},
+		"unknown subnetwork": {
+			Subnetwork: "projects/p/regions/us-central1/subnetworks/b",
+			Fields: map[string]string{
+				"ip_allocation_policy.0.cluster_secondary_range_name": "nodes",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		err := checkSubnetworkSecondaryRangeNames(existing, tc.Subnetwork, tc.Fields)
+		if tc.ExpectedError == "" {
+			if err != nil {
+				t.Errorf("bad: %s, expected no error, got %s", tn, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+			t.Errorf("bad: %s, expected an error containing %q, got %v", tn, tc.ExpectedError, err)
+		}
+	}
+}
@@ -0,0 +1,366 @@
+package google
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	compute "google.golang.org/api/compute/v0.beta"
+)
+
+// networkFirewallPolicyRef identifies a network firewall policy, either global
+// or regional, and dispatches the calls to the matching compute service.
+type networkFirewallPolicyRef struct {
+	project string
+	// Empty for global network firewall policies
+	region string
+	name   string
+}
+
+func getNetworkFirewallPolicyRef(d TerraformResourceData, config *Config, nameField string, regional bool) (networkFirewallPolicyRef, error) {
+	ref := networkFirewallPolicyRef{
+		name: GetResourceNameFromSelfLink(d.Get(nameField).(string)),
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return ref, err
+	}
+	ref.project = project
+
+	if regional {
+		region, err := getRegion(d, config)
+		if err != nil {
+			return ref, err
+		}
+		ref.region = region
+	}
+
+	return ref, nil
+}
+
+func (r networkFirewallPolicyRef) id() string {
+	if r.region == "" {
+		return fmt.Sprintf("projects/%s/global/firewallPolicies/%s", r.project, r.name)
+	}
+	return fmt.Sprintf("projects/%s/regions/%s/firewallPolicies/%s", r.project, r.region, r.name)
+}
+
+// lockName is the mutex held while the policy, its rules or its associations
+// are changed, as concurrent changes to a policy conflict with each other.
+func (r networkFirewallPolicyRef) lockName() string {
+	return "networkFirewallPolicy/" + r.id()
+}
+
+func (r networkFirewallPolicyRef) get(client *compute.Service) (*compute.FirewallPolicy, error) {
+	if r.region == "" {
+		return client.NetworkFirewallPolicies.Get(r.project, r.name).Do()
+	}
+	return client.RegionNetworkFirewallPolicies.Get(r.project, r.region, r.name).Do()
+}
+
+func (r networkFirewallPolicyRef) insert(client *compute.Service, policy *compute.FirewallPolicy) (*compute.Operation, error) {
+	if r.region == "" {
+		return client.NetworkFirewallPolicies.Insert(r.project, policy).Do()
+	}
+	return client.RegionNetworkFirewallPolicies.Insert(r.project, r.region, policy).Do()
+}
+
+func (r networkFirewallPolicyRef) patch(client *compute.Service, policy *compute.FirewallPolicy) (*compute.Operation, error) {
+	if r.region == "" {
+		return client.NetworkFirewallPolicies.Patch(r.project, r.name, policy).Do()
+	}
+	return client.RegionNetworkFirewallPolicies.Patch(r.project, r.region, r.name, policy).Do()
+}
+
+func (r networkFirewallPolicyRef) delete(client *compute.Service) (*compute.Operation, error) {
+	if r.region == "" {
+		return client.NetworkFirewallPolicies.Delete(r.project, r.name).Do()
+	}
+	return client.RegionNetworkFirewallPolicies.Delete(r.project, r.region, r.name).Do()
+}
+
+func (r networkFirewallPolicyRef) addRule(client *compute.Service, rule *compute.FirewallPolicyRule) (*compute.Operation, error) {
+	if r.region == "" {
+		return client.NetworkFirewallPolicies.AddRule(r.project, r.name, rule).Do()
+	}
+	return client.RegionNetworkFirewallPolicies.AddRule(r.project, r.region, r.name, rule).Do()
+}
+
+func (r networkFirewallPolicyRef) patchRule(client *compute.Service, priority int64, rule *compute.FirewallPolicyRule) (*compute.Operation, error) {
+	if r.region == "" {
+		return client.NetworkFirewallPolicies.PatchRule(r.project, r.name, rule).Priority(priority).Do()
+	}
+	return client.RegionNetworkFirewallPolicies.PatchRule(r.project, r.region, r.name, rule).Priority(priority).Do()
+}
+
+func (r networkFirewallPolicyRef) removeRule(client *compute.Service, priority int64) (*compute.Operation, error) {
+	if r.region == "" {
+		return client.NetworkFirewallPolicies.RemoveRule(r.project, r.name).Priority(priority).Do()
+	}
+	return client.RegionNetworkFirewallPolicies.RemoveRule(r.project, r.region, r.name).Priority(priority).Do()
+}
+
+func (r networkFirewallPolicyRef) addAssociation(client *compute.Service, association *compute.FirewallPolicyAssociation) (*compute.Operation, error) {
+	if r.region == "" {
+		return client.NetworkFirewallPolicies.AddAssociation(r.project, r.name, association).Do()
+	}
+	return client.RegionNetworkFirewallPolicies.AddAssociation(r.project, r.region, r.name, association).Do()
+}
+
+func (r networkFirewallPolicyRef) removeAssociation(client *compute.Service, name string) (*compute.Operation, error) {
+	if r.region == "" {
+		return client.NetworkFirewallPolicies.RemoveAssociation(r.project, r.name).Name(name).Do()
+	}
+	return client.RegionNetworkFirewallPolicies.RemoveAssociation(r.project, r.region, r.name).Name(name).Do()
+}
+
+// modify runs f while holding the policy lock and waits for the operation it
+// returns.
+func (r networkFirewallPolicyRef) modify(config *Config, userAgent, activity string, timeout time.Duration, f func(client *compute.Service) (*compute.Operation, error)) error {
+	mutexKV.Lock(r.lockName())
+	defer mutexKV.Unlock(r.lockName())
+
+	op, err := f(config.NewComputeClient(userAgent))
+	if err != nil {
+		return fmt.Errorf("Error %s: %s", activity, err)
+	}
+
+	return computeOperationWaitTime(config, op, r.project, activity, userAgent, timeout)
+}
+
+func computeNetworkFirewallPolicyRuleSecureTagSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Name of the secure tag, in the format tagValues/{tag_value_id}.`,
+			},
+
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `State of the secure tag, either EFFECTIVE or INEFFECTIVE. A secure tag is INEFFECTIVE when it is deleted or its network is deleted.`,
+			},
+		},
+	}
+}
+
+func computeNetworkFirewallPolicyRuleMatchSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"layer4_configs": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `Pairs of IP protocols and ports that the rule should match.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_protocol": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The IP protocol to which this rule applies. This value can either be one of the following well known protocol strings (`tcp`, `udp`, `icmp`, `esp`, `ah`, `ipip`, `sctp`), or the IP protocol number.",
+						},
+
+						"ports": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `An optional list of ports to which this rule applies. This field is only applicable for UDP or TCP protocol. Each entry must be either an integer or a range.`,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"src_ip_ranges": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `CIDR IP address range. Maximum number of source CIDR IP ranges allowed is 5000.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"dest_ip_ranges": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `CIDR IP address range. Maximum number of destination CIDR IP ranges allowed is 5000.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"src_address_groups": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Address groups which should be matched against the source IP of the traffic. Only allowed for INGRESS rules.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"dest_address_groups": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Address groups which should be matched against the destination IP of the traffic. Only allowed for EGRESS rules.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"src_secure_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `List of secure tag values, which should be matched at the source of the traffic. Only allowed for INGRESS rules.`,
+				Elem:        computeNetworkFirewallPolicyRuleSecureTagSchema(),
+			},
+
+			"src_fqdns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Domain names that will be used to match against the resolved domain name of the source of the traffic. Only allowed for INGRESS rules.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"dest_fqdns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Domain names that will be used to match against the resolved domain name of the destination of the traffic. Only allowed for EGRESS rules.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"src_region_codes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Two-letter ISO 3166-1 alpha-2 country codes of the source of the traffic. Only allowed for INGRESS rules.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"dest_region_codes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Two-letter ISO 3166-1 alpha-2 country codes of the destination of the traffic. Only allowed for EGRESS rules.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"src_threat_intelligences": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Names of Network Threat Intelligence lists. The IPs in these lists will be matched against the source of the traffic. Only allowed for INGRESS rules.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"dest_threat_intelligences": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Names of Network Threat Intelligence lists. The IPs in these lists will be matched against the destination of the traffic. Only allowed for EGRESS rules.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func expandComputeNetworkFirewallPolicyRule(d *schema.ResourceData) *compute.FirewallPolicyRule {
+	return &compute.FirewallPolicyRule{
+		Priority:              int64(d.Get("priority").(int)),
+		Action:                d.Get("action").(string),
+		Direction:             d.Get("direction").(string),
+		Match:                 expandComputeNetworkFirewallPolicyRuleMatch(d.Get("match").([]interface{})),
+		Description:           d.Get("description").(string),
+		RuleName:              d.Get("rule_name").(string),
+		Disabled:              d.Get("disabled").(bool),
+		EnableLogging:         d.Get("enable_logging").(bool),
+		TargetSecureTags:      expandComputeNetworkFirewallPolicyRuleSecureTags(d.Get("target_secure_tags").([]interface{})),
+		TargetServiceAccounts: convertStringArr(d.Get("target_service_accounts").([]interface{})),
+		// Send the empty values so that patching a rule clears the removed fields
+		ForceSendFields: []string{"Description", "RuleName", "Disabled", "EnableLogging", "TargetSecureTags", "TargetServiceAccounts"},
+	}
+}
+
+func expandComputeNetworkFirewallPolicyRuleMatch(configured []interface{}) *compute.FirewallPolicyRuleMatcher {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+	raw := configured[0].(map[string]interface{})
+
+	match := &compute.FirewallPolicyRuleMatcher{
+		SrcIpRanges:             convertStringArr(raw["src_ip_ranges"].([]interface{})),
+		DestIpRanges:            convertStringArr(raw["dest_ip_ranges"].([]interface{})),
+		SrcAddressGroups:        convertStringArr(raw["src_address_groups"].([]interface{})),
+		DestAddressGroups:       convertStringArr(raw["dest_address_groups"].([]interface{})),
+		SrcFqdns:                convertStringArr(raw["src_fqdns"].([]interface{})),
+		DestFqdns:               convertStringArr(raw["dest_fqdns"].([]interface{})),
+		SrcRegionCodes:          convertStringArr(raw["src_region_codes"].([]interface{})),
+		DestRegionCodes:         convertStringArr(raw["dest_region_codes"].([]interface{})),
+		SrcThreatIntelligences:  convertStringArr(raw["src_threat_intelligences"].([]interface{})),
+		DestThreatIntelligences: convertStringArr(raw["dest_threat_intelligences"].([]interface{})),
+		ForceSendFields: []string{"SrcIpRanges", "DestIpRanges", "SrcAddressGroups", "DestAddressGroups", "SrcFqdns", "DestFqdns",
+			"SrcRegionCodes", "DestRegionCodes", "SrcThreatIntelligences", "DestThreatIntelligences"},
+	}
+
+	// Organization firewall policy rules don't support secure tags in their match.
+	if v, ok := raw["src_secure_tags"]; ok {
+		match.SrcSecureTags = expandComputeNetworkFirewallPolicyRuleSecureTags(v.([]interface{}))
+		match.ForceSendFields = append(match.ForceSendFields, "SrcSecureTags")
+	}
+
+	for _, l4 := range raw["layer4_configs"].([]interface{}) {
+		if l4 == nil {
+			continue
+		}
+		l4Config := l4.(map[string]interface{})
+		match.Layer4Configs = append(match.Layer4Configs, &compute.FirewallPolicyRuleMatcherLayer4Config{
+			IpProtocol: l4Config["ip_protocol"].(string),
+			Ports:      convertStringArr(l4Config["ports"].([]interface{})),
+		})
+	}
+
+	return match
+}
+
+func expandComputeNetworkFirewallPolicyRuleSecureTags(configured []interface{}) []*compute.FirewallPolicyRuleSecureTag {
+	tags := make([]*compute.FirewallPolicyRuleSecureTag, 0, len(configured))
+	for _, raw := range configured {
+		if raw == nil {
+			continue
+		}
+		tags = append(tags, &compute.FirewallPolicyRuleSecureTag{
+			Name: raw.(map[string]interface{})["name"].(string),
+		})
+	}
+	return tags
+}
+
+func flattenComputeNetworkFirewallPolicyRuleMatch(match *compute.FirewallPolicyRuleMatcher) []map[string]interface{} {
+	if match == nil {
+		return nil
+	}
+
+	layer4Configs := make([]map[string]interface{}, 0, len(match.Layer4Configs))
+	for _, l4 := range match.Layer4Configs {
+		layer4Configs = append(layer4Configs, map[string]interface{}{
+			"ip_protocol": l4.IpProtocol,
+			"ports":       l4.Ports,
+		})
+	}
+
+	data := map[string]interface{}{
+		"layer4_configs":            layer4Configs,
+		"src_ip_ranges":             match.SrcIpRanges,
+		"dest_ip_ranges":            match.DestIpRanges,
+		"src_address_groups":        match.SrcAddressGroups,
+		"dest_address_groups":       match.DestAddressGroups,
+		"src_secure_tags":           flattenComputeNetworkFirewallPolicyRuleSecureTags(match.SrcSecureTags),
+		"src_fqdns":                 match.SrcFqdns,
+		"dest_fqdns":                match.DestFqdns,
+		"src_region_codes":          match.SrcRegionCodes,
+		"dest_region_codes":         match.DestRegionCodes,
+		"src_threat_intelligences":  match.SrcThreatIntelligences,
+		"dest_threat_intelligences": match.DestThreatIntelligences,
+	}
+
+	return []map[string]interface{}{data}
+}
+
+func flattenComputeNetworkFirewallPolicyRuleSecureTags(tags []*compute.FirewallPolicyRuleSecureTag) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(tags))
+	for _, tag := range tags {
+		flattened = append(flattened, map[string]interface{}{
+			"name":  tag.Name,
+			"state": tag.State,
+		})
+	}
+	return flattened
+}
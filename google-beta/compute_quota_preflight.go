@@ -0,0 +1,362 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	compute "google.golang.org/api/compute/v0.beta"
+)
+
+// computeQuotaPreflight sums up the quota that the compute resources planned in
+// this provider process will consume, so that CustomizeDiff can catch an apply
+// that runs out of quota halfway before any resource is created.
+//
+// Resources are keyed by their identity, as the same resource may be planned
+// more than once in a process, e.g. when terraform apply plans again.
+type computeQuotaPreflight struct {
+	// WARN shows a warning in the plan when quota would be exceeded, FAIL fails
+	// the plan.
+	mode string
+
+	mu sync.Mutex
+	// regions is keyed by "project/region".
+	regions map[string]*compute.Region
+	// planned is keyed by "project/region", then by resource, then by metric.
+	planned map[string]map[string]map[string]float64
+}
+
+func newComputeQuotaPreflight(mode string) *computeQuotaPreflight {
+	return &computeQuotaPreflight{
+		mode:    mode,
+		regions: make(map[string]*compute.Region),
+		planned: make(map[string]map[string]map[string]float64),
+	}
+}
+
+// region returns the region, including its quota, caching it for the lifetime
+// of the provider process. It must be called while holding p.mu.
+func (p *computeQuotaPreflight) region(config *Config, userAgent, project, region string) (*compute.Region, error) {
+	key := project + "/" + region
+	if r, ok := p.regions[key]; ok {
+		return r, nil
+	}
+
+	r, err := config.NewComputeClient(userAgent).Regions.Get(project, region).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Error reading quota of region %q for preflight_quota_check: %s", region, err)
+	}
+	p.regions[key] = r
+	return r, nil
+}
+
+// computeQuotaPreflightWarningSchema is the attribute through which the resources
+// checked by preflight_quota_check show the warnings of WARN mode in the plan,
+// as CustomizeDiff can only fail a plan.
+func computeQuotaPreflightWarningSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: `The quota shortfall, or the error preventing the quota check, found while planning the resource when the provider is configured with preflight_quota_check = "WARN".`,
+	}
+}
+
+// surface fails the plan with err in FAIL mode. In WARN mode, err is shown in
+// the plan as the resource's preflight_quota_warning instead. Read clears the
+// attribute, so a warning never outlives the plan that found it.
+func (p *computeQuotaPreflight) surface(diff *schema.ResourceDiff, err error) error {
+	if p.mode != "WARN" || err == nil {
+		return err
+	}
+
+	log.Printf("[WARN] %s", err)
+	return diff.SetNew("preflight_quota_warning", err.Error())
+}
+
+// check records the planned consumption of resource and reports the metrics
+// for which all planned resources together exceed the available quota.
+func (p *computeQuotaPreflight) check(config *Config, userAgent, project, region, resource string, consumption map[string]float64) error {
+	if len(consumption) == 0 {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	r, err := p.region(config, userAgent, project, region)
+	if err != nil {
+		return err
+	}
+
+	key := project + "/" + region
+	if p.planned[key] == nil {
+		p.planned[key] = make(map[string]map[string]float64)
+	}
+	p.planned[key][resource] = consumption
+
+	shortfalls := computeQuotaShortfalls(r.Quotas, p.planned[key], consumption)
+	if len(shortfalls) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("Planned resources would exceed the quota of region %q in project %q while creating %s:\n  - %s",
+		region, project, resource, strings.Join(shortfalls, "\n  - "))
+}
+
+// computeQuotaShortfalls returns a description of every metric consumed by
+// consumption for which the usage plus all planned consumption is over the limit.
+func computeQuotaShortfalls(quotas []*compute.Quota, planned map[string]map[string]float64, consumption map[string]float64) []string {
+	metrics := make([]string, 0, len(consumption))
+	for metric := range consumption {
+		metrics = append(metrics, metric)
+	}
+	sort.Strings(metrics)
+
+	var shortfalls []string
+	for _, metric := range metrics {
+		var quota *compute.Quota
+		for _, q := range quotas {
+			if q.Metric == metric {
+				quota = q
+				break
+			}
+		}
+		if quota == nil {
+			continue
+		}
+
+		total := 0.0
+		for _, c := range planned {
+			total += c[metric]
+		}
+		if quota.Usage+total > quota.Limit {
+			shortfalls = append(shortfalls, fmt.Sprintf("%s: %g planned, but only %g of %g available", metric, total, quota.Limit-quota.Usage, quota.Limit))
+		}
+	}
+	return shortfalls
+}
+
+// addComputeDiskQuotaConsumption adds a new persistent disk to consumption.
+// Disk types without a regional quota metric are ignored.
+func addComputeDiskQuotaConsumption(consumption map[string]float64, diskType string, sizeGb int64) {
+	if sizeGb <= 0 {
+		return
+	}
+
+	switch GetResourceNameFromSelfLink(diskType) {
+	case "pd-ssd", "pd-balanced":
+		consumption["SSD_TOTAL_GB"] += float64(sizeGb)
+	case "pd-standard", "":
+		consumption["DISKS_TOTAL_GB"] += float64(sizeGb)
+	}
+}
+
+// addComputeMachineTypeQuotaConsumption adds the CPUs of a single instance of
+// machineType to consumption.
+func addComputeMachineTypeQuotaConsumption(consumption map[string]float64, client *compute.Service, project, zone, machineType string, preemptible bool) error {
+	mt, err := client.MachineTypes.Get(project, zone, GetResourceNameFromSelfLink(machineType)).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading machine type %q for preflight_quota_check: %s", machineType, err)
+	}
+
+	if preemptible {
+		consumption["PREEMPTIBLE_CPUS"] += float64(mt.GuestCpus)
+	} else {
+		consumption["CPUS"] += float64(mt.GuestCpus)
+	}
+	return nil
+}
+
+func computeInstanceQuotaPreflight(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*Config)
+	if config.quotaPreflight == nil || diff.Id() != "" {
+		return nil
+	}
+	return config.quotaPreflight.surface(diff, computeInstanceQuotaPreflightCheck(diff, config))
+}
+
+func computeInstanceQuotaPreflightCheck(diff *schema.ResourceDiff, config *Config) error {
+	if !diff.NewValueKnown("machine_type") {
+		return nil
+	}
+
+	d := resourceDiffData{diff}
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	consumption := make(map[string]float64)
+	preemptible := diff.Get("scheduling.0.preemptible").(bool) || diff.Get("scheduling.0.provisioning_model").(string) == "SPOT"
+	err = addComputeMachineTypeQuotaConsumption(consumption, config.NewComputeClient(userAgent), project, zone, diff.Get("machine_type").(string), preemptible)
+	if err != nil {
+		return err
+	}
+	addComputeDiskQuotaConsumption(consumption, diff.Get("boot_disk.0.initialize_params.0.type").(string), int64(diff.Get("boot_disk.0.initialize_params.0.size").(int)))
+	for i := 0; i < diff.Get("network_interface.#").(int); i++ {
+		for j := 0; j < diff.Get(fmt.Sprintf("network_interface.%d.access_config.#", i)).(int); j++ {
+			// Access configs without a nat_ip get an ephemeral external address
+			if diff.Get(fmt.Sprintf("network_interface.%d.access_config.%d.nat_ip", i, j)).(string) == "" {
+				consumption["IN_USE_ADDRESSES"]++
+			}
+		}
+	}
+
+	resource := fmt.Sprintf("google_compute_instance %q", fmt.Sprintf("projects/%s/zones/%s/instances/%s", project, zone, diff.Get("name")))
+	return config.quotaPreflight.check(config, userAgent, project, getRegionFromZone(zone), resource, consumption)
+}
+
+// computeInstanceGroupManagerQuotaPreflight checks the quota consumed by the
+// instances a managed instance group adds, based on the instance template of
+// its first version.
+func computeInstanceGroupManagerQuotaPreflight(regional bool) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		config := meta.(*Config)
+		if config.quotaPreflight == nil {
+			return nil
+		}
+		if !diff.NewValueKnown("target_size") {
+			return nil
+		}
+		o, n := diff.GetChange("target_size")
+		added := n.(int) - o.(int)
+		if added <= 0 {
+			return nil
+		}
+		return config.quotaPreflight.surface(diff, computeInstanceGroupManagerQuotaPreflightCheck(diff, config, regional, added))
+	}
+}
+
+func computeInstanceGroupManagerQuotaPreflightCheck(diff *schema.ResourceDiff, config *Config, regional bool, added int) error {
+	// The properties of an instance template created or replaced in the same
+	// run aren't known until it's applied, so the group isn't checked then.
+	if !diff.NewValueKnown("version.0.instance_template") {
+		return nil
+	}
+	template := diff.Get("version.0.instance_template").(string)
+	// Only global instance templates are supported
+	if strings.Contains(template, "/regions/") {
+		return nil
+	}
+
+	d := resourceDiffData{diff}
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	var region, zone, resource string
+	if regional {
+		region, err = getRegion(d, config)
+		if err != nil {
+			return err
+		}
+		resource = fmt.Sprintf("google_compute_region_instance_group_manager %q", fmt.Sprintf("projects/%s/regions/%s/instanceGroupManagers/%s", project, region, diff.Get("name")))
+	} else {
+		zone, err = getZone(d, config)
+		if err != nil {
+			return err
+		}
+		region = getRegionFromZone(zone)
+		resource = fmt.Sprintf("google_compute_instance_group_manager %q", fmt.Sprintf("projects/%s/zones/%s/instanceGroupManagers/%s", project, zone, diff.Get("name")))
+	}
+
+	client := config.NewComputeClient(userAgent)
+	it, err := client.InstanceTemplates.Get(project, GetResourceNameFromSelfLink(template)).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading instance template %q for preflight_quota_check: %s", template, err)
+	}
+	props := it.Properties
+	if props == nil {
+		return nil
+	}
+
+	if zone == "" {
+		// Machine types are looked up by zone, any zone of the region will do.
+		config.quotaPreflight.mu.Lock()
+		r, err := config.quotaPreflight.region(config, userAgent, project, region)
+		config.quotaPreflight.mu.Unlock()
+		if err != nil {
+			return err
+		}
+		if len(r.Zones) == 0 {
+			return nil
+		}
+		zone = GetResourceNameFromSelfLink(r.Zones[0])
+	}
+
+	perInstance := make(map[string]float64)
+	preemptible := props.Scheduling != nil && (props.Scheduling.Preemptible || props.Scheduling.ProvisioningModel == "SPOT")
+	if err := addComputeMachineTypeQuotaConsumption(perInstance, client, project, zone, props.MachineType, preemptible); err != nil {
+		return err
+	}
+	for _, disk := range props.Disks {
+		if disk.InitializeParams != nil {
+			addComputeDiskQuotaConsumption(perInstance, disk.InitializeParams.DiskType, disk.InitializeParams.DiskSizeGb)
+		}
+	}
+	for _, ni := range props.NetworkInterfaces {
+		for _, ac := range ni.AccessConfigs {
+			if ac.NatIP == "" {
+				perInstance["IN_USE_ADDRESSES"]++
+			}
+		}
+	}
+
+	consumption := make(map[string]float64, len(perInstance))
+	for metric, amount := range perInstance {
+		consumption[metric] = amount * float64(added)
+	}
+
+	return config.quotaPreflight.check(config, userAgent, project, region, resource, consumption)
+}
+
+func computeAddressQuotaPreflight(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*Config)
+	if config.quotaPreflight == nil || diff.Id() != "" {
+		return nil
+	}
+	return config.quotaPreflight.surface(diff, computeAddressQuotaPreflightCheck(diff, config))
+}
+
+func computeAddressQuotaPreflightCheck(diff *schema.ResourceDiff, config *Config) error {
+	if diff.Get("address_type").(string) == "INTERNAL" {
+		return nil
+	}
+
+	d := resourceDiffData{diff}
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	consumption := map[string]float64{
+		"STATIC_ADDRESSES": 1,
+	}
+	resource := fmt.Sprintf("google_compute_address %q", fmt.Sprintf("projects/%s/regions/%s/addresses/%s", project, region, diff.Get("name")))
+	return config.quotaPreflight.check(config, userAgent, project, region, resource, consumption)
+}
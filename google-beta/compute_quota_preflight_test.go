@@ -0,0 +1,93 @@
+package google
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	compute "google.golang.org/api/compute/v0.beta"
+)
+
+func TestComputeQuotaShortfalls(t *testing.T) {
+	quotas := []*compute.Quota{
+		{Metric: "CPUS", Limit: 24, Usage: 16},
+		{Metric: "IN_USE_ADDRESSES", Limit: 8, Usage: 0},
+	}
+
+	cases := map[string]struct {
+		Planned     map[string]map[string]float64
+		Consumption map[string]float64
+		Shortfalls  []string
+	}{
+		"fits": {
+			Planned: map[string]map[string]float64{
+				"a": {"CPUS": 4},
+				"b": {"CPUS": 4},
+			},
+			Consumption: map[string]float64{"CPUS": 4},
+		},
+		"exceeded by all planned resources": {
+			Planned: map[string]map[string]float64{
+				"a": {"CPUS": 4},
+				"b": {"CPUS": 8, "IN_USE_ADDRESSES": 1},
+			},
+			Consumption: map[string]float64{"CPUS": 8, "IN_USE_ADDRESSES": 1},
+			Shortfalls:  []string{"CPUS: 12 planned, but only 8 of 24 available"},
+		},
+		"unknown metric": {
+			Planned: map[string]map[string]float64{
+				"a": {"N2_CPUS": 100},
+			},
+			Consumption: map[string]float64{"N2_CPUS": 100},
+		},
+	}
+
+	for tn, tc := range cases {
+		shortfalls := computeQuotaShortfalls(quotas, tc.Planned, tc.Consumption)
+		if !reflect.DeepEqual(shortfalls, tc.Shortfalls) {
+			t.Errorf("%s: expected shortfalls %v, got %v", tn, tc.Shortfalls, shortfalls)
+		}
+	}
+}
+
+func TestComputeQuotaPreflightCheck(t *testing.T) {
+	region := &compute.Region{
+		Quotas: []*compute.Quota{
+			{Metric: "CPUS", Limit: 8, Usage: 4},
+		},
+	}
+
+	p := newComputeQuotaPreflight("FAIL")
+	// Prime the cache so that no request is made
+	p.regions["my-project/us-central1"] = region
+
+	if err := p.check(nil, "", "my-project", "us-central1", "instance-a", map[string]float64{"CPUS": 2}); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	// Planning the same resource again doesn't count twice
+	if err := p.check(nil, "", "my-project", "us-central1", "instance-a", map[string]float64{"CPUS": 2}); err != nil {
+		t.Fatalf("expected no error when planning a resource again, got %s", err)
+	}
+
+	err := p.check(nil, "", "my-project", "us-central1", "instance-b", map[string]float64{"CPUS": 4})
+	if err == nil || !strings.Contains(err.Error(), "CPUS: 6 planned, but only 4 of 8 available") {
+		t.Errorf("expected quota error, got %v", err)
+	}
+}
+
+func TestAddComputeDiskQuotaConsumption(t *testing.T) {
+	consumption := make(map[string]float64)
+	addComputeDiskQuotaConsumption(consumption, "pd-ssd", 100)
+	addComputeDiskQuotaConsumption(consumption, "projects/my-project/zones/us-central1-a/diskTypes/pd-balanced", 50)
+	addComputeDiskQuotaConsumption(consumption, "", 10)
+	addComputeDiskQuotaConsumption(consumption, "pd-standard", 0)
+	addComputeDiskQuotaConsumption(consumption, "hyperdisk-extreme", 1000)
+
+	expected := map[string]float64{
+		"SSD_TOTAL_GB":   150,
+		"DISKS_TOTAL_GB": 10,
+	}
+	if !reflect.DeepEqual(consumption, expected) {
+		t.Errorf("expected consumption %v, got %v", expected, consumption)
+	}
+}
@@ -0,0 +1,119 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceDiffData lets the generated expanders, which take a TerraformResourceData,
+// read from a *schema.ResourceDiff during plan. Setters are no-ops since nothing is
+// persisted from CustomizeDiff.
+type resourceDiffData struct {
+	*schema.ResourceDiff
+}
+
+func (d resourceDiffData) Set(string, interface{}) error {
+	return nil
+}
+
+func (d resourceDiffData) SetId(string) {}
+
+func (d resourceDiffData) GetProviderMeta(interface{}) error {
+	return nil
+}
+
+func (d resourceDiffData) Timeout(string) time.Duration {
+	return 20 * time.Minute
+}
+
+// urlMapConfigWhollyKnown reports whether every value in the url map config is
+// known, i.e. no referenced backend service or bucket is still to be created.
+func urlMapConfigWhollyKnown(diff *schema.ResourceDiff) bool {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() {
+		return false
+	}
+	return rawConfig.IsWhollyKnown()
+}
+
+// validateUrlMapOnPlan sends the expanded url map to the given validate url and
+// converts load errors and failing tests in the response into a single error.
+func validateUrlMapOnPlan(config *Config, d TerraformResourceData, url string, obj map[string]interface{}) error {
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for UrlMap: %s", err)
+	}
+	billingProject = project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	res, err := sendRequest(config, "POST", billingProject, url, userAgent, map[string]interface{}{"resource": obj})
+	if err != nil {
+		return fmt.Errorf("Error validating UrlMap %q: %s", d.Get("name"), err)
+	}
+
+	return flattenUrlMapValidationResult(res)
+}
+
+func flattenUrlMapValidationResult(res map[string]interface{}) error {
+	result, ok := res["result"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var problems []string
+	if loadErrors, ok := result["loadErrors"].([]interface{}); ok {
+		for _, loadError := range loadErrors {
+			problems = append(problems, fmt.Sprintf("load error: %v", loadError))
+		}
+	}
+	if testFailures, ok := result["testFailures"].([]interface{}); ok {
+		for _, raw := range testFailures {
+			failure, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			problems = append(problems, flattenUrlMapTestFailure(failure))
+		}
+	}
+	if len(problems) == 0 {
+		if loadSucceeded, ok := result["loadSucceeded"].(bool); ok && !loadSucceeded {
+			problems = append(problems, "url map failed to load")
+		}
+		if testPassed, ok := result["testPassed"].(bool); ok && !testPassed {
+			problems = append(problems, "url map tests failed")
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("UrlMap validation failed:\n  - %s", strings.Join(problems, "\n  - "))
+}
+
+func flattenUrlMapTestFailure(failure map[string]interface{}) string {
+	msg := fmt.Sprintf("test failed for host %q and path %q", failure["host"], failure["path"])
+	if expected, ok := failure["expectedService"]; ok {
+		msg += fmt.Sprintf(": expected service %v, got %v", expected, failure["actualService"])
+	}
+	if expected, ok := failure["expectedOutputUrl"]; ok {
+		msg += fmt.Sprintf(": expected output url %v, got %v", expected, failure["actualOutputUrl"])
+	}
+	if expected, ok := failure["expectedRedirectResponseCode"]; ok {
+		msg += fmt.Sprintf(": expected redirect response code %v, got %v", expected, failure["actualRedirectResponseCode"])
+	}
+	return msg
+}
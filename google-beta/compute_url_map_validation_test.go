@@ -0,0 +1,88 @@
+package google
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFlattenUrlMapValidationResult(t *testing.T) {
+	cases := map[string]struct {
+		Response map[string]interface{}
+		Errors   []string
+	}{
+		"no result": {
+			Response: map[string]interface{}{},
+		},
+		"passed": {
+			Response: map[string]interface{}{
+				"result": map[string]interface{}{
+					"loadSucceeded": true,
+					"testPassed":    true,
+				},
+			},
+		},
+		"load errors": {
+			Response: map[string]interface{}{
+				"result": map[string]interface{}{
+					"loadSucceeded": false,
+					"loadErrors":    []interface{}{"path matcher boop is not defined"},
+				},
+			},
+			Errors: []string{"load error: path matcher boop is not defined"},
+		},
+		"test failures": {
+			Response: map[string]interface{}{
+				"result": map[string]interface{}{
+					"loadSucceeded": true,
+					"testPassed":    false,
+					"testFailures": []interface{}{
+						map[string]interface{}{
+							"host":            "mysite.com",
+							"path":            "/",
+							"expectedService": "projects/p/global/backendServices/foo",
+							"actualService":   "projects/p/global/backendServices/bar",
+						},
+						map[string]interface{}{
+							"host":                         "mysite.com",
+							"path":                         "/old",
+							"expectedRedirectResponseCode": 301,
+							"actualRedirectResponseCode":   302,
+						},
+					},
+				},
+			},
+			Errors: []string{
+				`test failed for host "mysite.com" and path "/": expected service projects/p/global/backendServices/foo, got projects/p/global/backendServices/bar`,
+				`test failed for host "mysite.com" and path "/old": expected redirect response code 301, got 302`,
+			},
+		},
+		"failed without details": {
+			Response: map[string]interface{}{
+				"result": map[string]interface{}{
+					"loadSucceeded": true,
+					"testPassed":    false,
+				},
+			},
+			Errors: []string{"url map tests failed"},
+		},
+	}
+
+	for tn, tc := range cases {
+		err := flattenUrlMapValidationResult(tc.Response)
+		if len(tc.Errors) == 0 {
+			if err != nil {
+				t.Errorf("%s: expected no error, got %s", tn, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("%s: expected an error, got none", tn)
+			continue
+		}
+		for _, expected := range tc.Errors {
+			if !strings.Contains(err.Error(), expected) {
+				t.Errorf("%s: expected error to contain %q, got %s", tn, expected, err)
+			}
+		}
+	}
+}
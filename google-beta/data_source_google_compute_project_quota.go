@@ -0,0 +1,90 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	compute "google.golang.org/api/compute/v0.beta"
+)
+
+func dataSourceGoogleComputeProjectQuota() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeProjectQuotaRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"quotas": computeQuotasSchema(),
+		},
+	}
+}
+
+func computeQuotasSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"metric": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"limit": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"usage": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeProjectQuotaRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	resp, err := config.NewComputeClient(userAgent).Projects.Get(project).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading quota of project %q: %s", project, err)
+	}
+
+	if err := d.Set("quotas", flattenComputeQuotas(resp.Quotas)); err != nil {
+		return fmt.Errorf("Error setting quotas: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s", project))
+
+	return nil
+}
+
+func flattenComputeQuotas(quotas []*compute.Quota) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(quotas))
+	for _, quota := range quotas {
+		result = append(result, map[string]interface{}{
+			"metric": quota.Metric,
+			"limit":  quota.Limit,
+			"usage":  quota.Usage,
+			"owner":  quota.Owner,
+		})
+	}
+	return result
+}
@@ -0,0 +1,33 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleComputeProjectQuota_basic(t *testing.T) {
+	t.Parallel()
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeProjectQuotaConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.google_compute_project_quota.quota", "project"),
+					resource.TestCheckResourceAttrSet("data.google_compute_project_quota.quota", "quotas.0.metric"),
+					resource.TestCheckResourceAttrSet("data.google_compute_project_quota.quota", "quotas.0.limit"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_compute_project_quota.quota", "quotas.*", map[string]string{
+						"metric": "NETWORKS",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceGoogleComputeProjectQuotaConfig = `
+data "google_compute_project_quota" "quota" {}
+`
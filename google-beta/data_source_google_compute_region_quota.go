@@ -0,0 +1,61 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleComputeRegionQuota() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeRegionQuotaRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"quotas": computeQuotasSchema(),
+		},
+	}
+}
+
+func dataSourceGoogleComputeRegionQuotaRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	resp, err := config.NewComputeClient(userAgent).Regions.Get(project, region).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading quota of region %q: %s", region, err)
+	}
+
+	if err := d.Set("quotas", flattenComputeQuotas(resp.Quotas)); err != nil {
+		return fmt.Errorf("Error setting quotas: %s", err)
+	}
+	if err := d.Set("region", region); err != nil {
+		return fmt.Errorf("Error setting region: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/regions/%s", project, region))
+
+	return nil
+}
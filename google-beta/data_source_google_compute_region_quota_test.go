@@ -0,0 +1,35 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleComputeRegionQuota_basic(t *testing.T) {
+	t.Parallel()
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeRegionQuotaConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_region_quota.quota", "region", "us-central1"),
+					resource.TestCheckResourceAttrSet("data.google_compute_region_quota.quota", "quotas.0.metric"),
+					resource.TestCheckResourceAttrSet("data.google_compute_region_quota.quota", "quotas.0.limit"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_compute_region_quota.quota", "quotas.*", map[string]string{
+						"metric": "CPUS",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceGoogleComputeRegionQuotaConfig = `
+data "google_compute_region_quota" "quota" {
+  region = "us-central1"
+}
+`
@@ -0,0 +1,125 @@
+package google
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/cloudkms/v1"
+)
+
+func dataSourceGoogleKmsCryptoKeyVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleKmsCryptoKeyVersionsRead,
+		Schema: map[string]*schema.Schema{
+			"crypto_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The CryptoKey to list the versions of.`,
+			},
+			"filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Only list the versions matching this filter, e.g. 'state=ENABLED'.`,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     kmsCryptoKeyVersionDataSourceSchema(),
+			},
+		},
+	}
+}
+
+// kmsCryptoKeyVersionDataSourceSchema is the schema of a CryptoKeyVersion
+// listed by a data source.
+func kmsCryptoKeyVersionDataSourceSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"algorithm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"protection_level": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"generate_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destroy_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"import_job": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGoogleKmsCryptoKeyVersionsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	cryptoKeyId, err := parseKmsCryptoKeyId(d.Get("crypto_key").(string), config)
+	if err != nil {
+		return err
+	}
+
+	listCall := config.NewKmsClient(userAgent).Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.List(cryptoKeyId.cryptoKeyId())
+	if filter, ok := d.GetOk("filter"); ok {
+		listCall.Filter(filter.(string))
+	}
+	if config.UserProjectOverride {
+		listCall.Header().Set("X-Goog-User-Project", cryptoKeyId.KeyRingId.Project)
+	}
+
+	versions := make([]map[string]interface{}, 0)
+	err = listCall.Pages(context.Background(), func(res *cloudkms.ListCryptoKeyVersionsResponse) error {
+		for _, version := range res.CryptoKeyVersions {
+			versions = append(versions, flattenKmsCryptoKeyVersionListItem(version))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing CryptoKeyVersions of CryptoKey %q: %s", cryptoKeyId.cryptoKeyId(), err)
+	}
+
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("Error setting versions: %s", err)
+	}
+
+	d.SetId(cryptoKeyId.cryptoKeyId())
+	return nil
+}
+
+func flattenKmsCryptoKeyVersionListItem(v *cloudkms.CryptoKeyVersion) map[string]interface{} {
+	return map[string]interface{}{
+		"id":               v.Name,
+		"version":          GetResourceNameFromSelfLink(v.Name),
+		"state":            v.State,
+		"algorithm":        v.Algorithm,
+		"protection_level": v.ProtectionLevel,
+		"generate_time":    v.GenerateTime,
+		"destroy_time":     v.DestroyTime,
+		"import_job":       v.ImportJob,
+	}
+}
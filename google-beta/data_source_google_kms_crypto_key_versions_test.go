@@ -0,0 +1,36 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleKmsCryptoKeyVersions_basic(t *testing.T) {
+	kms := BootstrapKMSKey(t)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleKmsCryptoKeyVersions_basic(kms.CryptoKey.Name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_kms_crypto_key_versions.versions", "versions.0.version", "1"),
+					resource.TestCheckResourceAttr("data.google_kms_crypto_key_versions.versions", "versions.0.state", "ENABLED"),
+					resource.TestCheckResourceAttr("data.google_kms_crypto_key_versions.versions", "versions.0.algorithm", "GOOGLE_SYMMETRIC_ENCRYPTION"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleKmsCryptoKeyVersions_basic(kmsKey string) string {
+	return fmt.Sprintf(`
+data "google_kms_crypto_key_versions" "versions" {
+  crypto_key = "%s"
+  filter     = "name:cryptoKeyVersions/1"
+}
+`, kmsKey)
+}
@@ -0,0 +1,125 @@
+package google
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/cloudkms/v1"
+)
+
+func dataSourceGoogleKmsCryptoKeys() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleKmsCryptoKeysRead,
+		Schema: map[string]*schema.Schema{
+			"key_ring": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The KeyRing to list the keys of.`,
+			},
+			"filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Only list the keys matching this filter, e.g. 'purpose=ENCRYPT_DECRYPT'.`,
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"purpose": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"rotation_period": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destroy_scheduled_duration": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"import_only": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"primary_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"primary": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     kmsCryptoKeyVersionDataSourceSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleKmsCryptoKeysRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	keyRingId, err := parseKmsKeyRingId(d.Get("key_ring").(string), config)
+	if err != nil {
+		return err
+	}
+
+	listCall := config.NewKmsClient(userAgent).Projects.Locations.KeyRings.CryptoKeys.List(keyRingId.keyRingId())
+	if filter, ok := d.GetOk("filter"); ok {
+		listCall.Filter(filter.(string))
+	}
+	if config.UserProjectOverride {
+		listCall.Header().Set("X-Goog-User-Project", keyRingId.Project)
+	}
+
+	keys := make([]map[string]interface{}, 0)
+	err = listCall.Pages(context.Background(), func(res *cloudkms.ListCryptoKeysResponse) error {
+		for _, key := range res.CryptoKeys {
+			k := map[string]interface{}{
+				"id":                         key.Name,
+				"name":                       GetResourceNameFromSelfLink(key.Name),
+				"purpose":                    key.Purpose,
+				"labels":                     key.Labels,
+				"rotation_period":            key.RotationPeriod,
+				"destroy_scheduled_duration": key.DestroyScheduledDuration,
+				"import_only":                key.ImportOnly,
+			}
+			if key.Primary != nil {
+				k["primary_version"] = GetResourceNameFromSelfLink(key.Primary.Name)
+				k["primary"] = []interface{}{flattenKmsCryptoKeyVersionListItem(key.Primary)}
+			}
+			keys = append(keys, k)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing CryptoKeys of KeyRing %q: %s", keyRingId.keyRingId(), err)
+	}
+
+	if err := d.Set("keys", keys); err != nil {
+		return fmt.Errorf("Error setting keys: %s", err)
+	}
+
+	d.SetId(keyRingId.keyRingId())
+	return nil
+}
@@ -0,0 +1,38 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleKmsCryptoKeys_basic(t *testing.T) {
+	kms := BootstrapKMSKey(t)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleKmsCryptoKeys_basic(kms.KeyRing.Name, kms.CryptoKey.Name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_kms_crypto_keys.keys", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.google_kms_crypto_keys.keys", "keys.0.id", kms.CryptoKey.Name),
+					resource.TestCheckResourceAttr("data.google_kms_crypto_keys.keys", "keys.0.purpose", "ENCRYPT_DECRYPT"),
+					resource.TestCheckResourceAttrSet("data.google_kms_crypto_keys.keys", "keys.0.primary.0.state"),
+					resource.TestCheckResourceAttr("data.google_kms_crypto_keys.keys", "keys.0.primary.0.algorithm", "GOOGLE_SYMMETRIC_ENCRYPTION"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleKmsCryptoKeys_basic(keyRing, cryptoKey string) string {
+	return fmt.Sprintf(`
+data "google_kms_crypto_keys" "keys" {
+  key_ring = "%s"
+  filter   = "name:%s"
+}
+`, keyRing, GetResourceNameFromSelfLink(cryptoKey))
+}
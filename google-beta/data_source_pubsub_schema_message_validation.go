@@ -0,0 +1,126 @@
+package google
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGooglePubsubSchemaMessageValidation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGooglePubsubSchemaMessageValidationRead,
+		Schema: map[string]*schema.Schema{
+			"schema": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"schema", "definition"},
+				Description: `The schema to validate the message against, as a name or in the format projects/{project}/schemas/{schema}.
+A revision can be selected by appending @{revision_id}, the latest revision is used otherwise.`,
+			},
+			"definition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"type"},
+				Description:  `An inline schema definition to validate the message against, instead of an existing schema.`,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"definition"},
+				ValidateFunc: validation.StringInSlice([]string{"PROTOCOL_BUFFER", "AVRO"}, false),
+				Description:  `The type of the inline schema definition. Possible values: ["PROTOCOL_BUFFER", "AVRO"]`,
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The message to validate. Messages with the BINARY encoding must be base64-encoded.`,
+			},
+			"encoding": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "JSON",
+				ValidateFunc: validation.StringInSlice([]string{"JSON", "BINARY"}, false),
+				Description:  `The encoding of the message. Default value: "JSON" Possible values: ["JSON", "BINARY"]`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGooglePubsubSchemaMessageValidationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Schema: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	message := d.Get("message").(string)
+	encoding := d.Get("encoding").(string)
+	if encoding == "BINARY" {
+		if _, err := base64.StdEncoding.DecodeString(message); err != nil {
+			return fmt.Errorf("Error decoding message, BINARY messages must be base64-encoded: %s", err)
+		}
+	} else {
+		message = base64.StdEncoding.EncodeToString([]byte(message))
+	}
+
+	obj := map[string]interface{}{
+		"message":  message,
+		"encoding": encoding,
+	}
+
+	var id string
+	if v, ok := d.GetOk("schema"); ok {
+		name := v.(string)
+		if !strings.HasPrefix(name, "projects/") {
+			name = fmt.Sprintf("projects/%s/schemas/%s", project, name)
+		}
+		obj["name"] = name
+		id = name
+	} else {
+		obj["schema"] = map[string]interface{}{
+			"type":       d.Get("type").(string),
+			"definition": d.Get("definition").(string),
+		}
+		id = fmt.Sprintf("projects/%s/schemas", project)
+	}
+
+	url, err := replaceVars(d, config, "{{PubsubBasePath}}projects/{{project}}/schemas:validateMessage")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Validating message against %s", id)
+	if _, err := sendRequest(config, "POST", billingProject, url, userAgent, obj); err != nil {
+		if isGoogleApiErrorWithCode(err, 400) {
+			return fmt.Errorf("Message is not valid against %s: %s", id, err)
+		}
+		return fmt.Errorf("Error validating message against %s: %s", id, err)
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+
+	d.SetId(id)
+	return nil
+}
@@ -0,0 +1,54 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGooglePubsubSchemaMessageValidation_basic(t *testing.T) {
+	t.Parallel()
+
+	schema := fmt.Sprintf("tf-test-schema-%s", randString(t, 10))
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGooglePubsubSchemaMessageValidation(schema, `{"StringField":"foo"}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.google_pubsub_schema_message_validation.schema", "id", "google_pubsub_schema.foo", "id"),
+					resource.TestCheckResourceAttr("data.google_pubsub_schema_message_validation.inline", "encoding", "JSON"),
+				),
+			},
+			{
+				Config:      testAccDataSourceGooglePubsubSchemaMessageValidation(schema, `{"IntField":1}`),
+				ExpectError: regexp.MustCompile("Message is not valid"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGooglePubsubSchemaMessageValidation(schema, message string) string {
+	return fmt.Sprintf(`
+resource "google_pubsub_schema" "foo" {
+  name       = "%s"
+  type       = "AVRO"
+  definition = %q
+}
+
+data "google_pubsub_schema_message_validation" "schema" {
+  schema  = google_pubsub_schema.foo.id
+  message = %q
+}
+
+data "google_pubsub_schema_message_validation" "inline" {
+  type       = "AVRO"
+  definition = %q
+  message    = %q
+}
+`, schema, testAccPubsubSchemaDefinitionV1, message, testAccPubsubSchemaDefinitionV1, message)
+}
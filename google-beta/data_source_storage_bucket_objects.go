@@ -0,0 +1,207 @@
+package google
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/api/storage/v1"
+)
+
+// errStorageBucketObjectsMaxResults stops paging once max_results objects
+// have been read.
+var errStorageBucketObjectsMaxResults = errors.New("max_results reached")
+
+func dataSourceGoogleStorageBucketObjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleStorageBucketObjectsRead,
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The name of the bucket to list objects from.`,
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Only list objects whose names begin with this prefix.`,
+			},
+			"delimiter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Return results in a directory-like mode. Objects whose names contain the delimiter after the prefix are omitted, and the names up to and including the delimiter are returned in prefixes instead.`,
+			},
+			"match_glob": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Only list objects whose names match this glob pattern.`,
+			},
+			"versions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Whether to list noncurrent versions of the objects as well.`,
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  `The maximum number of objects to return. All matching objects are returned when unset.`,
+			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"generation": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"md5hash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"crc32c": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_deleted": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"media_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"self_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"prefixes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The prefixes of the objects omitted because their names contain the delimiter.`,
+			},
+		},
+	}
+}
+
+func dataSourceGoogleStorageBucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	delimiter := d.Get("delimiter").(string)
+	matchGlob := d.Get("match_glob").(string)
+	maxResults := d.Get("max_results").(int)
+
+	listCall := config.NewStorageClient(userAgent).Objects.List(bucket).Versions(d.Get("versions").(bool))
+	if prefix != "" {
+		listCall.Prefix(prefix)
+	}
+	if delimiter != "" {
+		listCall.Delimiter(delimiter)
+	}
+	if matchGlob != "" {
+		listCall.MatchGlob(matchGlob)
+	}
+	if maxResults > 0 && maxResults < 1000 {
+		listCall.MaxResults(int64(maxResults))
+	}
+
+	objects := make([]map[string]interface{}, 0)
+	prefixes := make([]string, 0)
+	err = listCall.Pages(context.Background(), func(res *storage.Objects) error {
+		// Objects and prefixes are listed in lexical order, so that no prefix
+		// past the last object returned is collected once max_results is reached
+		items, pagePrefixes := res.Items, res.Prefixes
+		for len(items) > 0 || len(pagePrefixes) > 0 {
+			if maxResults > 0 && len(objects) >= maxResults {
+				return errStorageBucketObjectsMaxResults
+			}
+			if len(pagePrefixes) == 0 || (len(items) > 0 && items[0].Name < pagePrefixes[0]) {
+				objects = append(objects, flattenDatasourceStorageBucketObject(items[0]))
+				items = items[1:]
+			} else {
+				prefixes = append(prefixes, pagePrefixes[0])
+				pagePrefixes = pagePrefixes[1:]
+			}
+		}
+		if maxResults > 0 && len(objects) >= maxResults {
+			return errStorageBucketObjectsMaxResults
+		}
+		return nil
+	})
+	if err != nil && err != errStorageBucketObjectsMaxResults {
+		return fmt.Errorf("Error listing objects in bucket %q: %s", bucket, err)
+	}
+
+	if err := d.Set("objects", objects); err != nil {
+		return fmt.Errorf("Error setting objects: %s", err)
+	}
+	if err := d.Set("prefixes", prefixes); err != nil {
+		return fmt.Errorf("Error setting prefixes: %s", err)
+	}
+
+	id := url.Values{}
+	id.Set("prefix", prefix)
+	id.Set("delimiter", delimiter)
+	id.Set("match_glob", matchGlob)
+	id.Set("versions", strconv.FormatBool(d.Get("versions").(bool)))
+	id.Set("max_results", strconv.Itoa(maxResults))
+	d.SetId(fmt.Sprintf("%s?%s", bucket, id.Encode()))
+	return nil
+}
+
+func flattenDatasourceStorageBucketObject(o *storage.Object) map[string]interface{} {
+	return map[string]interface{}{
+		"name":          o.Name,
+		"generation":    int(o.Generation),
+		"size":          int(o.Size),
+		"md5hash":       o.Md5Hash,
+		"crc32c":        o.Crc32c,
+		"content_type":  o.ContentType,
+		"storage_class": o.StorageClass,
+		"updated":       o.Updated,
+		"time_deleted":  o.TimeDeleted,
+		"metadata":      o.Metadata,
+		"media_link":    o.MediaLink,
+		"self_link":     o.SelfLink,
+	}
+}
@@ -0,0 +1,131 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceStorageBucketObjects_basic(t *testing.T) {
+	t.Parallel()
+
+	bucket := "tf-bucket-objects-" + randString(t, 10)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceStorageBucketObjects_basic(bucket),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_storage_bucket_objects.all", "objects.#", "4"),
+					resource.TestCheckResourceAttr("data.google_storage_bucket_objects.all", "prefixes.#", "0"),
+
+					resource.TestCheckResourceAttr("data.google_storage_bucket_objects.builds", "objects.#", "1"),
+					resource.TestCheckResourceAttr("data.google_storage_bucket_objects.builds", "objects.0.name", "builds/app/README.md"),
+					resource.TestCheckResourceAttr("data.google_storage_bucket_objects.builds", "objects.0.size", "6"),
+					resource.TestCheckResourceAttr("data.google_storage_bucket_objects.builds", "objects.0.metadata.owner", "ci"),
+					resource.TestCheckResourceAttrSet("data.google_storage_bucket_objects.builds", "objects.0.md5hash"),
+					resource.TestCheckResourceAttrSet("data.google_storage_bucket_objects.builds", "objects.0.crc32c"),
+					resource.TestCheckResourceAttrSet("data.google_storage_bucket_objects.builds", "objects.0.generation"),
+					resource.TestCheckResourceAttrSet("data.google_storage_bucket_objects.builds", "objects.0.updated"),
+					resource.TestCheckResourceAttr("data.google_storage_bucket_objects.builds", "prefixes.#", "2"),
+					resource.TestCheckResourceAttr("data.google_storage_bucket_objects.builds", "prefixes.0", "builds/app/v1/"),
+
+					resource.TestCheckResourceAttr("data.google_storage_bucket_objects.tarballs", "objects.#", "2"),
+
+					resource.TestCheckResourceAttr("data.google_storage_bucket_objects.first", "objects.#", "1"),
+					resource.TestCheckResourceAttr("data.google_storage_bucket_objects.first", "objects.0.name", "builds/app/README.md"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceStorageBucketObjects_basic(bucket string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name          = "%s"
+  location      = "US"
+  force_destroy = true
+}
+
+resource "google_storage_bucket_object" "readme" {
+  name    = "builds/app/README.md"
+  content = "readme"
+  bucket  = google_storage_bucket.bucket.name
+
+  metadata = {
+    owner = "ci"
+  }
+}
+
+resource "google_storage_bucket_object" "v1" {
+  name    = "builds/app/v1/app.tar.gz"
+  content = "v1"
+  bucket  = google_storage_bucket.bucket.name
+}
+
+resource "google_storage_bucket_object" "v2" {
+  name    = "builds/app/v2/app.tar.gz"
+  content = "v2"
+  bucket  = google_storage_bucket.bucket.name
+}
+
+resource "google_storage_bucket_object" "other" {
+  name    = "other.txt"
+  content = "other"
+  bucket  = google_storage_bucket.bucket.name
+}
+
+data "google_storage_bucket_objects" "all" {
+  bucket = google_storage_bucket.bucket.name
+
+  depends_on = [
+    google_storage_bucket_object.readme,
+    google_storage_bucket_object.v1,
+    google_storage_bucket_object.v2,
+    google_storage_bucket_object.other,
+  ]
+}
+
+data "google_storage_bucket_objects" "builds" {
+  bucket    = google_storage_bucket.bucket.name
+  prefix    = "builds/app/"
+  delimiter = "/"
+
+  depends_on = [
+    google_storage_bucket_object.readme,
+    google_storage_bucket_object.v1,
+    google_storage_bucket_object.v2,
+    google_storage_bucket_object.other,
+  ]
+}
+
+data "google_storage_bucket_objects" "tarballs" {
+  bucket     = google_storage_bucket.bucket.name
+  match_glob = "builds/**.tar.gz"
+
+  depends_on = [
+    google_storage_bucket_object.readme,
+    google_storage_bucket_object.v1,
+    google_storage_bucket_object.v2,
+    google_storage_bucket_object.other,
+  ]
+}
+
+data "google_storage_bucket_objects" "first" {
+  bucket      = google_storage_bucket.bucket.name
+  prefix      = "builds/"
+  max_results = 1
+
+  depends_on = [
+    google_storage_bucket_object.readme,
+    google_storage_bucket_object.v1,
+    google_storage_bucket_object.v2,
+    google_storage_bucket_object.other,
+  ]
+}
+`, bucket)
+}
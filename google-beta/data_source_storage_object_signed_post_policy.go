@@ -0,0 +1,130 @@
+package google
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGoogleStorageObjectSignedPostPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleStorageObjectSignedPostPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"content_length_range": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"max": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"credentials": {
+				Type:      schema.TypeString,
+				Sensitive: true,
+				Optional:  true,
+			},
+			"duration": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "1h",
+			},
+			"fields": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"service_account_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"virtual_hosted_style": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"form_fields": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleStorageObjectSignedPostPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	duration, err := signedUrlDuration(d)
+	if err != nil {
+		return err
+	}
+
+	policy := &PostPolicyV4{
+		Bucket:             d.Get("bucket").(string),
+		Object:             d.Get("path").(string),
+		Fields:             make(map[string]string),
+		VirtualHostedStyle: d.Get("virtual_hosted_style").(bool),
+		Time:               time.Now().UTC(),
+		Expires:            duration,
+	}
+
+	for k, v := range d.Get("fields").(map[string]interface{}) {
+		policy.Fields[k] = v.(string)
+	}
+
+	if v, ok := d.GetOk("content_length_range"); ok {
+		r := v.([]interface{})[0].(map[string]interface{})
+		policy.ContentLengthMin = r["min"].(int)
+		policy.ContentLengthMax = r["max"].(int)
+		if policy.ContentLengthMin > policy.ContentLengthMax {
+			return fmt.Errorf("content_length_range.min (%d) can't be greater than max (%d)", policy.ContentLengthMin, policy.ContentLengthMax)
+		}
+	}
+
+	signer, err := loadStorageSigner(d, config)
+	if err != nil {
+		return err
+	}
+	policy.Signer = signer
+
+	fields, err := policy.SignedFields()
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("url", policy.Url()); err != nil {
+		return fmt.Errorf("Error setting url: %s", err)
+	}
+	if err := d.Set("form_fields", fields); err != nil {
+		return fmt.Errorf("Error setting form_fields: %s", err)
+	}
+	d.SetId(fields["x-goog-signature"])
+
+	return nil
+}
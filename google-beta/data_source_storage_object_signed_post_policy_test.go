@@ -0,0 +1,112 @@
+package google
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccStorageSignedPostPolicy_upload(t *testing.T) {
+	// The policy includes an expiration time
+	skipIfVcr(t)
+	t.Parallel()
+
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", randInt(t))
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTestGoogleStorageObjectSignedPostPolicy(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_storage_object_signed_post_policy.upload", "url", fmt.Sprintf("https://storage.googleapis.com/%s/", bucketName)),
+					testAccSignedPostPolicyUpload("data.google_storage_object_signed_post_policy.upload", "once upon a time...", http.StatusNoContent),
+					testAccSignedPostPolicyUpload("data.google_storage_object_signed_post_policy.upload", strings.Repeat("too long ", 16), http.StatusBadRequest),
+				),
+			},
+		},
+	})
+}
+
+func testAccSignedPostPolicyUpload(n, content string, expectedStatus int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		r := s.RootModule().Resources[n]
+		if r == nil {
+			return fmt.Errorf("Datasource not found")
+		}
+		a := r.Primary.Attributes
+
+		var body bytes.Buffer
+		w := multipart.NewWriter(&body)
+		for k, v := range a {
+			if !strings.HasPrefix(k, "form_fields.") || k == "form_fields.%" {
+				continue
+			}
+			if err := w.WriteField(strings.TrimPrefix(k, "form_fields."), v); err != nil {
+				return err
+			}
+		}
+		// The file must be the last field of the form
+		f, err := w.CreateFormFile("file", "file.txt")
+		if err != nil {
+			return err
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			return err
+		}
+		if err := w.Close(); err != nil {
+			return err
+		}
+
+		req, err := http.NewRequest("POST", a["url"], &body)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", w.FormDataContentType())
+
+		response, err := cleanhttp.DefaultClient().Do(req)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+
+		if response.StatusCode != expectedStatus {
+			b, _ := ioutil.ReadAll(response.Body)
+			return fmt.Errorf("expected status %d uploading with the POST policy, got %d: %s", expectedStatus, response.StatusCode, string(b))
+		}
+		return nil
+	}
+}
+
+func testAccTestGoogleStorageObjectSignedPostPolicy(bucketName string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name          = "%s"
+  location      = "US"
+  force_destroy = true
+}
+
+data "google_storage_object_signed_post_policy" "upload" {
+  bucket = google_storage_bucket.bucket.name
+  path   = "uploads/story.txt"
+
+  fields = {
+    success_action_status = "204"
+  }
+
+  content_length_range {
+    min = 1
+    max = 64
+  }
+}
+`, bucketName)
+}
@@ -74,6 +74,20 @@ func dataSourceGoogleSignedUrl() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"service_account_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"signing_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "v2",
+				ValidateFunc: validation.StringInSlice([]string{"v2", "v4"}, false),
+			},
+			"virtual_hosted_style": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"signed_url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -96,6 +110,13 @@ func validateExtensionHeaders(v interface{}, k string) (ws []string, errors []er
 func dataSourceGoogleSignedUrlRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if d.Get("signing_version").(string) == "v4" {
+		return dataSourceGoogleSignedUrlReadV4(d, config)
+	}
+	if d.Get("virtual_hosted_style").(bool) {
+		return fmt.Errorf("virtual_hosted_style requires signing_version to be v4")
+	}
+
 	// Build UrlData object from data source attributes
 	urlData := &UrlData{}
 
@@ -105,13 +126,9 @@ func dataSourceGoogleSignedUrlRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	// convert duration to an expiration datetime (unix time in seconds)
-	durationString := "1h"
-	if v, ok := d.GetOk("duration"); ok {
-		durationString = v.(string)
-	}
-	duration, err := time.ParseDuration(durationString)
+	duration, err := signedUrlDuration(d)
 	if err != nil {
-		return errwrap.Wrapf("could not parse duration: {{err}}", err)
+		return err
 	}
 	expires := time.Now().Unix() + int64(duration.Seconds())
 	urlData.Expires = int(expires)
@@ -140,12 +157,12 @@ func dataSourceGoogleSignedUrlRead(d *schema.ResourceData, meta interface{}) err
 
 	urlData.Path = fmt.Sprintf("/%s/%s", d.Get("bucket").(string), d.Get("path").(string))
 
-	// Load JWT Config from Google Credentials
-	jwtConfig, err := loadJwtConfig(d, config)
+	// Load the signer from Google Credentials
+	signer, err := loadStorageSigner(d, config)
 	if err != nil {
 		return err
 	}
-	urlData.JwtConfig = jwtConfig
+	urlData.Signer = signer
 
 	// Construct URL
 	signedUrl, err := urlData.SignedUrl()
@@ -167,6 +184,65 @@ func dataSourceGoogleSignedUrlRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func signedUrlDuration(d *schema.ResourceData) (time.Duration, error) {
+	durationString := "1h"
+	if v, ok := d.GetOk("duration"); ok {
+		durationString = v.(string)
+	}
+	duration, err := time.ParseDuration(durationString)
+	if err != nil {
+		return 0, errwrap.Wrapf("could not parse duration: {{err}}", err)
+	}
+	return duration, nil
+}
+
+func dataSourceGoogleSignedUrlReadV4(d *schema.ResourceData, config *Config) error {
+	duration, err := signedUrlDuration(d)
+	if err != nil {
+		return err
+	}
+
+	urlData := &UrlDataV4{
+		Bucket:             d.Get("bucket").(string),
+		Object:             d.Get("path").(string),
+		HttpMethod:         strings.ToUpper(d.Get("http_method").(string)),
+		ContentMd5:         d.Get("content_md5").(string),
+		ContentType:        d.Get("content_type").(string),
+		VirtualHostedStyle: d.Get("virtual_hosted_style").(bool),
+		Time:               time.Now().UTC(),
+		Expires:            duration,
+	}
+
+	if v, ok := d.GetOk("extension_headers"); ok {
+		urlData.HttpHeaders = make(map[string]string)
+		for k, v := range v.(map[string]interface{}) {
+			urlData.HttpHeaders[k] = v.(string)
+		}
+	}
+
+	signer, err := loadStorageSigner(d, config)
+	if err != nil {
+		return err
+	}
+	urlData.Signer = signer
+
+	signedUrl, err := urlData.SignedUrl()
+	if err != nil {
+		return err
+	}
+	if err := d.Set("signed_url", signedUrl); err != nil {
+		return fmt.Errorf("Error setting signed_url: %s", err)
+	}
+
+	u, err := url.Parse(signedUrl)
+	if err != nil {
+		return err
+	}
+	d.SetId(u.Query().Get("X-Goog-Signature"))
+
+	return nil
+}
+
 // loadJwtConfig looks for credentials json in the following places,
 // in order of preference:
 //  1. `credentials` attribute of the datasource
@@ -233,6 +309,8 @@ func parsePrivateKey(key []byte) (*rsa.PrivateKey, error) {
 
 // UrlData stores the values required to create a Signed Url
 type UrlData struct {
+	// Signer takes precedence over JwtConfig when set
+	Signer      *StorageSigner
 	JwtConfig   *jwt.Config
 	ContentMd5  string
 	ContentType string
@@ -289,6 +367,10 @@ func (u *UrlData) SigningString() []byte {
 }
 
 func (u *UrlData) Signature() ([]byte, error) {
+	if u.Signer != nil {
+		return u.Signer.Sign(u.SigningString())
+	}
+
 	// Sign url data
 	signature, err := SignString(u.SigningString(), u.JwtConfig)
 	if err != nil {
@@ -328,7 +410,11 @@ func (u *UrlData) SignedUrl() (string, error) {
 	urlBuffer.WriteString(gcsBaseUrl)
 	urlBuffer.WriteString(u.Path)
 	urlBuffer.WriteString("?GoogleAccessId=")
-	urlBuffer.WriteString(u.JwtConfig.Email)
+	if u.Signer != nil {
+		urlBuffer.WriteString(u.Signer.Email)
+	} else {
+		urlBuffer.WriteString(u.JwtConfig.Email)
+	}
 	urlBuffer.WriteString("&Expires=")
 	urlBuffer.WriteString(strconv.Itoa(u.Expires))
 	urlBuffer.WriteString("&Signature=")
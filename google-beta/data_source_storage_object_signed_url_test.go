@@ -145,6 +145,33 @@ func TestAccStorageSignedUrl_accTest(t *testing.T) {
 	})
 }
 
+func TestAccStorageSignedUrl_v4(t *testing.T) {
+	// URL includes an expires time
+	skipIfVcr(t)
+	t.Parallel()
+
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", randInt(t))
+
+	headers := map[string]string{
+		"x-goog-test": "foo",
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTestGoogleStorageObjectSignedURLV4(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccSignedUrlRetrieval("data.google_storage_object_signed_url.story_url", nil),
+					testAccSignedUrlRetrieval("data.google_storage_object_signed_url.story_url_virtual_hosted", nil),
+					testAccSignedUrlRetrieval("data.google_storage_object_signed_url.story_url_w_headers", headers),
+				),
+			},
+		},
+	})
+}
+
 func testAccSignedUrlExists(t *testing.T, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -268,3 +295,42 @@ data "google_storage_object_signed_url" "story_url_w_md5" {
 }
 `, bucketName)
 }
+
+func testAccTestGoogleStorageObjectSignedURLV4(bucketName string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name     = "%s"
+  location = "US"
+}
+
+resource "google_storage_bucket_object" "story" {
+  name   = "path/to/file"
+  bucket = google_storage_bucket.bucket.name
+
+  content = "once upon a time..."
+}
+
+data "google_storage_object_signed_url" "story_url" {
+  bucket          = google_storage_bucket.bucket.name
+  path            = google_storage_bucket_object.story.name
+  signing_version = "v4"
+  duration        = "168h"
+}
+
+data "google_storage_object_signed_url" "story_url_virtual_hosted" {
+  bucket               = google_storage_bucket.bucket.name
+  path                 = google_storage_bucket_object.story.name
+  signing_version      = "v4"
+  virtual_hosted_style = true
+}
+
+data "google_storage_object_signed_url" "story_url_w_headers" {
+  bucket          = google_storage_bucket.bucket.name
+  path            = google_storage_bucket_object.story.name
+  signing_version = "v4"
+  extension_headers = {
+    x-goog-test = "foo"
+  }
+}
+`, bucketName)
+}
@@ -0,0 +1,370 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/dns/v1"
+)
+
+// A token of a zone file entry. Quoted tokens are stored without their
+// surrounding quotes, but with their escape sequences.
+type dnsZoneFileToken struct {
+	value  string
+	quoted bool
+}
+
+// An entry of a zone file, i.e. a record or a directive. Entries spanning
+// several lines within parentheses are joined.
+type dnsZoneFileEntry struct {
+	line   int
+	tokens []dnsZoneFileToken
+	// set when the entry starts with a blank, so its owner is the previous one
+	inheritsOwner bool
+}
+
+// The rdata fields holding domain names, which are qualified with the
+// origin when they're relative.
+var dnsZoneFileDomainNameFields = map[string][]int{
+	"CNAME": {0},
+	"DNAME": {0},
+	"MX":    {1},
+	"NS":    {0},
+	"PTR":   {0},
+	"SOA":   {0, 1},
+	"SRV":   {3},
+}
+
+// lexDnsZoneFile splits a zone file in RFC 1035 master file format into
+// entries, dropping comments.
+func lexDnsZoneFile(contents string) ([]dnsZoneFileEntry, error) {
+	var entries []dnsZoneFileEntry
+	var current dnsZoneFileEntry
+	var token strings.Builder
+	inToken, inQuote, lineStart := false, false, true
+	depth, line, entryLine := 0, 1, 1
+
+	flush := func() {
+		if inToken {
+			current.tokens = append(current.tokens, dnsZoneFileToken{value: token.String()})
+			token.Reset()
+			inToken = false
+		}
+	}
+
+	runes := []rune(contents)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		if inQuote {
+			switch r {
+			case '\\':
+				token.WriteRune(r)
+				if i+1 < len(runes) {
+					i++
+					token.WriteRune(runes[i])
+				}
+			case '"':
+				current.tokens = append(current.tokens, dnsZoneFileToken{value: token.String(), quoted: true})
+				token.Reset()
+				inQuote = false
+			case '\n':
+				return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+			default:
+				token.WriteRune(r)
+			}
+			continue
+		}
+
+		switch {
+		case r == '\n':
+			flush()
+			line++
+			if depth > 0 {
+				continue
+			}
+			if len(current.tokens) > 0 {
+				current.line = entryLine
+				entries = append(entries, current)
+			}
+			current = dnsZoneFileEntry{}
+			lineStart = true
+			entryLine = line
+			continue
+		case r == '\r':
+			continue
+		case r == ';':
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+		case r == ' ' || r == '\t':
+			if lineStart && depth == 0 && len(current.tokens) == 0 {
+				current.inheritsOwner = true
+			}
+			flush()
+		case r == '(':
+			flush()
+			depth++
+		case r == ')':
+			flush()
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+			}
+			depth--
+		case r == '"':
+			flush()
+			inQuote = true
+		case r == '\\':
+			inToken = true
+			token.WriteRune(r)
+			if i+1 < len(runes) {
+				i++
+				token.WriteRune(runes[i])
+			}
+		default:
+			inToken = true
+			token.WriteRune(r)
+		}
+		lineStart = false
+	}
+
+	if inQuote {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+	}
+	if depth > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+	}
+	flush()
+	if len(current.tokens) > 0 {
+		current.line = entryLine
+		entries = append(entries, current)
+	}
+	return entries, nil
+}
+
+// parseDnsTtl parses a TTL in seconds, or in the BIND format with units,
+// e.g. 1h30m.
+func parseDnsTtl(s string) (int64, error) {
+	units := map[rune]int64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	var ttl, n int64
+	hasDigits := false
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case r >= '0' && r <= '9':
+			n = n*10 + int64(r-'0')
+			hasDigits = true
+		case units[r] > 0 && hasDigits:
+			ttl += n * units[r]
+			n = 0
+			hasDigits = false
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+	}
+	if s == "" {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+	// trailing digits without a unit are seconds
+	return ttl + n, nil
+}
+
+func isDnsTtl(s string) bool {
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return false
+	}
+	_, err := parseDnsTtl(s)
+	return err == nil
+}
+
+// qualifyDnsName returns the fully qualified form of a name relative to
+// origin.
+func qualifyDnsName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+// isDnsNameInZone reports whether name is the apex of a zone, or one of its
+// subdomains.
+func isDnsNameInZone(name, zoneDnsName string) bool {
+	name, zoneDnsName = strings.ToLower(name), strings.ToLower(zoneDnsName)
+	return name == zoneDnsName || strings.HasSuffix(name, "."+zoneDnsName)
+}
+
+func formatDnsZoneFileRdata(rType string, tokens []dnsZoneFileToken, origin string) string {
+	parts := make([]string, 0, len(tokens))
+	for i, t := range tokens {
+		switch {
+		case t.quoted || rType == "TXT" || rType == "SPF":
+			parts = append(parts, `"`+t.value+`"`)
+		case intInSlice(dnsZoneFileDomainNameFields[rType], i):
+			parts = append(parts, qualifyDnsName(t.value, origin))
+		default:
+			parts = append(parts, t.value)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func intInSlice(arr []int, i int) bool {
+	for _, v := range arr {
+		if v == i {
+			return true
+		}
+	}
+	return false
+}
+
+// parseDnsZoneFile parses a zone file in RFC 1035 master file format into
+// record sets, with origin as the initial $ORIGIN. Records of the same name
+// and type are grouped into a single record set.
+func parseDnsZoneFile(contents, origin string) ([]*dns.ResourceRecordSet, error) {
+	entries, err := lexDnsZoneFile(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	var rrsets []*dns.ResourceRecordSet
+	byKey := make(map[string]*dns.ResourceRecordSet)
+	defaultTtl, lastTtl := int64(-1), int64(-1)
+	lastOwner := ""
+
+	for _, e := range entries {
+		first := e.tokens[0]
+		if !e.inheritsOwner && !first.quoted && strings.HasPrefix(first.value, "$") {
+			directive := strings.ToUpper(first.value)
+			switch directive {
+			case "$ORIGIN":
+				if len(e.tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN takes a single domain name", e.line)
+				}
+				origin = strings.ToLower(qualifyDnsName(e.tokens[1].value, origin))
+			case "$TTL":
+				if len(e.tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $TTL takes a single TTL", e.line)
+				}
+				if defaultTtl, err = parseDnsTtl(e.tokens[1].value); err != nil {
+					return nil, fmt.Errorf("line %d: %s", e.line, err)
+				}
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive %s", e.line, first.value)
+			}
+			continue
+		}
+
+		tokens := e.tokens
+		owner := lastOwner
+		if !e.inheritsOwner {
+			owner = strings.ToLower(qualifyDnsName(tokens[0].value, origin))
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", e.line)
+		}
+		lastOwner = owner
+
+		ttl := int64(-1)
+		for len(tokens) > 0 && !tokens[0].quoted {
+			if isDnsTtl(tokens[0].value) && ttl < 0 {
+				ttl, _ = parseDnsTtl(tokens[0].value)
+			} else if strings.EqualFold(tokens[0].value, "IN") {
+				// IN is the only class supported by Cloud DNS
+			} else if stringInSlice([]string{"CH", "CS", "HS"}, strings.ToUpper(tokens[0].value)) {
+				return nil, fmt.Errorf("line %d: unsupported class %s", e.line, tokens[0].value)
+			} else {
+				break
+			}
+			tokens = tokens[1:]
+		}
+
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: record must have a type and data", e.line)
+		}
+		rType := strings.ToUpper(tokens[0].value)
+
+		switch {
+		case ttl >= 0:
+		case defaultTtl >= 0:
+			ttl = defaultTtl
+		case lastTtl >= 0:
+			ttl = lastTtl
+		default:
+			return nil, fmt.Errorf("line %d: record has no TTL and no $TTL directive precedes it", e.line)
+		}
+		lastTtl = ttl
+
+		rrdata := formatDnsZoneFileRdata(rType, tokens[1:], origin)
+		key := owner + "/" + rType
+		if rrset, ok := byKey[key]; ok {
+			if rrset.Ttl != ttl {
+				// Like BIND, use the TTL of the first record of the set
+				log.Printf("[WARN] line %d: TTL %d of %s %s differs from the TTL %d of the previous records of the set, using %d", e.line, ttl, owner, rType, rrset.Ttl, rrset.Ttl)
+			}
+			rrset.Rrdatas = append(rrset.Rrdatas, rrdata)
+			continue
+		}
+		rrset := &dns.ResourceRecordSet{
+			Name:    owner,
+			Type:    rType,
+			Ttl:     ttl,
+			Rrdatas: []string{rrdata},
+		}
+		byKey[key] = rrset
+		rrsets = append(rrsets, rrset)
+	}
+
+	return rrsets, nil
+}
+
+// normalizeDnsRrdata returns the form of a record's data used to compare it,
+// as Cloud DNS may return it in a different format than configured.
+func normalizeDnsRrdata(rType, rrdata string) string {
+	switch rType {
+	case "A", "AAAA":
+		if ip := net.ParseIP(rrdata); ip != nil {
+			return ip.String()
+		}
+	case "TXT", "SPF":
+		entries, err := lexDnsZoneFile(rrdata)
+		if err != nil || len(entries) != 1 {
+			return rrdata
+		}
+		return formatDnsZoneFileRdata(rType, entries[0].tokens, "")
+	}
+	return rrdata
+}
+
+// normalizeDnsRecordSets sorts record sets by name and type, and normalizes
+// and sorts their data, so that equal record sets compare equal.
+func normalizeDnsRecordSets(rrsets []*dns.ResourceRecordSet) []*dns.ResourceRecordSet {
+	normalized := make([]*dns.ResourceRecordSet, 0, len(rrsets))
+	for _, rrset := range rrsets {
+		rType := strings.ToUpper(rrset.Type)
+		rrdatas := make([]string, 0, len(rrset.Rrdatas))
+		for _, rrdata := range rrset.Rrdatas {
+			rrdatas = append(rrdatas, normalizeDnsRrdata(rType, rrdata))
+		}
+		sort.Strings(rrdatas)
+		normalized = append(normalized, &dns.ResourceRecordSet{
+			Name:    strings.ToLower(rrset.Name),
+			Type:    rType,
+			Ttl:     rrset.Ttl,
+			Rrdatas: rrdatas,
+		})
+	}
+	sort.Slice(normalized, func(i, j int) bool {
+		if normalized[i].Name != normalized[j].Name {
+			return normalized[i].Name < normalized[j].Name
+		}
+		return normalized[i].Type < normalized[j].Type
+	})
+	return normalized
+}
@@ -0,0 +1,129 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/api/dns/v1"
+)
+
+func TestParseDnsZoneFile(t *testing.T) {
+	t.Parallel()
+
+	zoneFile := `
+$TTL 1h
+; the apex
+@	IN	SOA	ns-cloud-a1.googledomains.com. cloud-dns-hostmaster.google.com. (
+		1	; serial
+		21600	; refresh
+		3600	; retry
+		259200	; expire
+		300 )	; minimum
+	IN	MX	10 mail
+	IN	MX	20 mail.backup.example.net.
+	IN	TXT	"v=spf1 include:_spf.google.com ~all"
+www	300	IN	A	192.0.2.1
+	A	192.0.2.2
+WWW6	IN	300	AAAA	2001:db8::1
+long	TXT	("first part of a long record "
+		"second part")
+words	TXT	hello world
+_sip._tcp	SRV	0 5 5060 sip
+$ORIGIN sub.example.com.
+alias	CNAME	www.example.com.
+other	CNAME	www
+`
+
+	expected := []*dns.ResourceRecordSet{
+		{Name: "example.com.", Type: "SOA", Ttl: 3600, Rrdatas: []string{"ns-cloud-a1.googledomains.com. cloud-dns-hostmaster.google.com. 1 21600 3600 259200 300"}},
+		{Name: "example.com.", Type: "MX", Ttl: 3600, Rrdatas: []string{"10 mail.example.com.", "20 mail.backup.example.net."}},
+		{Name: "example.com.", Type: "TXT", Ttl: 3600, Rrdatas: []string{`"v=spf1 include:_spf.google.com ~all"`}},
+		{Name: "www.example.com.", Type: "A", Ttl: 300, Rrdatas: []string{"192.0.2.1", "192.0.2.2"}},
+		{Name: "www6.example.com.", Type: "AAAA", Ttl: 300, Rrdatas: []string{"2001:db8::1"}},
+		{Name: "long.example.com.", Type: "TXT", Ttl: 3600, Rrdatas: []string{`"first part of a long record " "second part"`}},
+		{Name: "words.example.com.", Type: "TXT", Ttl: 3600, Rrdatas: []string{`"hello" "world"`}},
+		{Name: "_sip._tcp.example.com.", Type: "SRV", Ttl: 3600, Rrdatas: []string{"0 5 5060 sip.example.com."}},
+		{Name: "alias.sub.example.com.", Type: "CNAME", Ttl: 3600, Rrdatas: []string{"www.example.com."}},
+		{Name: "other.sub.example.com.", Type: "CNAME", Ttl: 3600, Rrdatas: []string{"www.sub.example.com."}},
+	}
+
+	rrsets, err := parseDnsZoneFile(zoneFile, "example.com.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rrsets) != len(expected) {
+		t.Fatalf("expected %d record sets, got %d: %v", len(expected), len(rrsets), rrsets)
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(rrsets[i], expected[i]) {
+			t.Errorf("expected record set %d to be %+v, got %+v", i, expected[i], rrsets[i])
+		}
+	}
+}
+
+func TestParseDnsZoneFile_errors(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"no ttl":                "www IN A 192.0.2.1",
+		"no owner":              "$TTL 300\n  IN A 192.0.2.1",
+		"unsupported directive": "$INCLUDE other.zone",
+		"unsupported class":     "$TTL 300\nwww CH A 192.0.2.1",
+		"no data":               "$TTL 300\nwww IN A",
+		"unterminated quote":    "$TTL 300\nwww TXT \"hello\nworld\"",
+		"unbalanced":            "$TTL 300\n@ SOA a. b. ( 1 2 3 4 5",
+		"invalid ttl":           "$TTL 1x",
+	}
+
+	for tn, zoneFile := range cases {
+		if _, err := parseDnsZoneFile(zoneFile, "example.com."); err == nil {
+			t.Errorf("bad: %s, expected an error", tn)
+		}
+	}
+}
+
+func TestParseDnsTtl(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]int64{
+		"0":     0,
+		"300":   300,
+		"1h":    3600,
+		"1h30m": 5400,
+		"1W":    604800,
+		"1d2":   86402,
+	}
+
+	for s, expected := range cases {
+		ttl, err := parseDnsTtl(s)
+		if err != nil {
+			t.Errorf("bad: %s, unexpected error: %s", s, err)
+			continue
+		}
+		if ttl != expected {
+			t.Errorf("bad: %s, expected %d, got %d", s, expected, ttl)
+		}
+	}
+}
+
+func TestNormalizeDnsRecordSets(t *testing.T) {
+	t.Parallel()
+
+	rrsets := normalizeDnsRecordSets([]*dns.ResourceRecordSet{
+		{Name: "WWW.example.com.", Type: "aaaa", Ttl: 300, Rrdatas: []string{"2001:0db8:0000::0002", "2001:db8::1"}},
+		{Name: "example.com.", Type: "TXT", Ttl: 300, Rrdatas: []string{`"v=spf1 -all"`, `split "me"`}},
+	})
+
+	expected := []*dns.ResourceRecordSet{
+		{Name: "example.com.", Type: "TXT", Ttl: 300, Rrdatas: []string{`"split" "me"`, `"v=spf1 -all"`}},
+		{Name: "www.example.com.", Type: "AAAA", Ttl: 300, Rrdatas: []string{"2001:db8::1", "2001:db8::2"}},
+	}
+	if len(rrsets) != len(expected) {
+		t.Fatalf("expected %d record sets, got %d", len(expected), len(rrsets))
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(rrsets[i], expected[i]) {
+			t.Errorf("expected record set %d to be %+v, got %+v", i, expected[i], rrsets[i])
+		}
+	}
+}
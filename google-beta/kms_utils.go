@@ -1,6 +1,7 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -181,26 +182,25 @@ func clearCryptoKeyVersions(cryptoKeyId *kmsCryptoKeyId, userAgent string, confi
 	if config.UserProjectOverride {
 		listCall.Header().Set("X-Goog-User-Project", cryptoKeyId.KeyRingId.Project)
 	}
-	versionsResponse, err := listCall.Do()
 
-	if err != nil {
-		return err
-	}
-
-	for _, version := range versionsResponse.CryptoKeyVersions {
-		request := &cloudkms.DestroyCryptoKeyVersionRequest{}
-		destroyCall := versionsClient.Destroy(version.Name, request)
-		if config.UserProjectOverride {
-			destroyCall.Header().Set("X-Goog-User-Project", cryptoKeyId.KeyRingId.Project)
-		}
-		_, err = destroyCall.Do()
-
-		if err != nil {
-			return err
+	return listCall.Pages(context.Background(), func(versionsResponse *cloudkms.ListCryptoKeyVersionsResponse) error {
+		for _, version := range versionsResponse.CryptoKeyVersions {
+			// Versions can already be scheduled for destruction through google_kms_crypto_key_version
+			if version.State == "DESTROY_SCHEDULED" || version.State == "DESTROYED" {
+				continue
+			}
+
+			request := &cloudkms.DestroyCryptoKeyVersionRequest{}
+			destroyCall := versionsClient.Destroy(version.Name, request)
+			if config.UserProjectOverride {
+				destroyCall.Header().Set("X-Goog-User-Project", cryptoKeyId.KeyRingId.Project)
+			}
+			if _, err := destroyCall.Do(); err != nil {
+				return err
+			}
 		}
-	}
-
-	return nil
+		return nil
+	})
 }
 
 func disableCryptoKeyRotation(cryptoKeyId *kmsCryptoKeyId, userAgent string, config *Config) error {
@@ -216,3 +216,22 @@ func disableCryptoKeyRotation(cryptoKeyId *kmsCryptoKeyId, userAgent string, con
 
 	return err
 }
+
+func updateCryptoKeyPrimaryVersion(cryptoKeyId *kmsCryptoKeyId, version, userAgent string, config *Config) error {
+	keyClient := config.NewKmsClient(userAgent).Projects.Locations.KeyRings.CryptoKeys
+	updateCall := keyClient.UpdatePrimaryVersion(cryptoKeyId.cryptoKeyId(), &cloudkms.UpdateCryptoKeyPrimaryVersionRequest{
+		CryptoKeyVersionId: GetResourceNameFromSelfLink(version),
+	})
+	if config.UserProjectOverride {
+		updateCall.Header().Set("X-Goog-User-Project", cryptoKeyId.KeyRingId.Project)
+	}
+	_, err := updateCall.Do()
+
+	return err
+}
+
+// kmsCryptoKeyVersionsEquivalent compares crypto key versions given either as
+// a version ID or as a full resource name.
+func kmsCryptoKeyVersionsEquivalent(_, old, new string, _ *schema.ResourceData) bool {
+	return GetResourceNameFromSelfLink(old) == GetResourceNameFromSelfLink(new)
+}
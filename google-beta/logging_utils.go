@@ -58,3 +58,73 @@ func parseLoggingSinkId(id string) (*LoggingSinkId, error) {
 		name:         parts[3],
 	}, nil
 }
+
+// loggingSinkDestination is the resource a logging sink exports logs to, parsed from the sink's destination URI.
+type loggingSinkDestination struct {
+	// the service of the destination, e.g. `storage.googleapis.com`
+	service  string
+	project  string
+	location string
+	// the name of the bucket, dataset, topic or log bucket
+	name string
+}
+
+var loggingSinkDestinationRegexes = map[string]*regexp.Regexp{
+	"storage.googleapis.com":  regexp.MustCompile("^storage\\.googleapis\\.com/(?P<name>[^/]+)$"),
+	"bigquery.googleapis.com": regexp.MustCompile("^bigquery\\.googleapis\\.com/projects/(?P<project>[^/]+)/datasets/(?P<name>[^/]+)$"),
+	"pubsub.googleapis.com":   regexp.MustCompile("^pubsub\\.googleapis\\.com/projects/(?P<project>[^/]+)/topics/(?P<name>[^/]+)$"),
+	"logging.googleapis.com":  regexp.MustCompile("^logging\\.googleapis\\.com/projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/buckets/(?P<name>[^/]+)$"),
+}
+
+// parseLoggingSinkDestination parses the destination of a sink into a loggingSinkDestination, or returns an error
+// if it isn't a Cloud Storage bucket, a BigQuery dataset, a Pub/Sub topic or a log bucket.
+func parseLoggingSinkDestination(destination string) (*loggingSinkDestination, error) {
+	for service, re := range loggingSinkDestinationRegexes {
+		parts := re.FindStringSubmatch(destination)
+		if parts == nil {
+			continue
+		}
+		l := &loggingSinkDestination{service: service}
+		for i, name := range re.SubexpNames() {
+			switch name {
+			case "project":
+				l.project = parts[i]
+			case "location":
+				l.location = parts[i]
+			case "name":
+				l.name = parts[i]
+			}
+		}
+		return l, nil
+	}
+	return nil, fmt.Errorf("unable to parse logging sink destination %q, expected a Cloud Storage bucket, a BigQuery dataset, a Pub/Sub topic or a log bucket", destination)
+}
+
+// writerRole returns the role that allows a sink's writer identity to write to the destination.
+func (l loggingSinkDestination) writerRole() string {
+	switch l.service {
+	case "storage.googleapis.com":
+		return "roles/storage.objectCreator"
+	case "bigquery.googleapis.com":
+		return "roles/bigquery.dataEditor"
+	case "pubsub.googleapis.com":
+		return "roles/pubsub.publisher"
+	default:
+		return "roles/logging.bucketWriter"
+	}
+}
+
+// iamUpdater returns the updater of the IAM policy the writer role is granted in. Log buckets don't have IAM
+// policies, so the role is granted on their project.
+func (l loggingSinkDestination) iamUpdater(d TerraformResourceData, config *Config) ResourceIamUpdater {
+	switch l.service {
+	case "storage.googleapis.com":
+		return &StorageBucketIamUpdater{bucket: l.name, d: d, Config: config}
+	case "bigquery.googleapis.com":
+		return &BigqueryDatasetIamUpdater{project: l.project, datasetId: l.name, d: d, Config: config}
+	case "pubsub.googleapis.com":
+		return &PubsubTopicIamUpdater{project: l.project, topic: l.name, d: d, Config: config}
+	default:
+		return &ProjectIamUpdater{resourceId: l.project, d: d, Config: config}
+	}
+}
@@ -1,6 +1,10 @@
 package google
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 func TestParseLoggingSinkId(t *testing.T) {
 	tests := []struct {
@@ -58,3 +62,70 @@ func TestLoggingSinkId(t *testing.T) {
 		}
 	}
 }
+
+func TestParseLoggingSinkDestination(t *testing.T) {
+	tests := []struct {
+		val         string
+		out         *loggingSinkDestination
+		role        string
+		errExpected bool
+	}{
+		{"storage.googleapis.com/my-bucket", &loggingSinkDestination{service: "storage.googleapis.com", name: "my-bucket"}, "roles/storage.objectCreator", false},
+		{"bigquery.googleapis.com/projects/my-project/datasets/my_dataset", &loggingSinkDestination{service: "bigquery.googleapis.com", project: "my-project", name: "my_dataset"}, "roles/bigquery.dataEditor", false},
+		{"pubsub.googleapis.com/projects/my-project/topics/my-topic", &loggingSinkDestination{service: "pubsub.googleapis.com", project: "my-project", name: "my-topic"}, "roles/pubsub.publisher", false},
+		{"logging.googleapis.com/projects/my-project/locations/global/buckets/my-bucket", &loggingSinkDestination{service: "logging.googleapis.com", project: "my-project", location: "global", name: "my-bucket"}, "roles/logging.bucketWriter", false},
+		{"storage.googleapis.com/my-bucket/folder", nil, "", true},
+		{"bigquery.googleapis.com/my_dataset", nil, "", true},
+		{"example.com/my-bucket", nil, "", true},
+	}
+
+	for _, test := range tests {
+		out, err := parseLoggingSinkDestination(test.val)
+		if err != nil {
+			if !test.errExpected {
+				t.Errorf("Got error with val %#v: error = %#v", test.val, err)
+			}
+			continue
+		}
+		if test.errExpected {
+			t.Errorf("Expected an error with val %#v, got %#v", test.val, out)
+			continue
+		}
+		if *out != *test.out {
+			t.Errorf("Mismatch on val %#v: expected %#v but got %#v", test.val, test.out, out)
+		}
+		if role := out.writerRole(); role != test.role {
+			t.Errorf("Role mismatch on val %#v: expected %#v but got %#v", test.val, test.role, role)
+		}
+	}
+}
+
+func TestLoggingSinkDestinationIamUpdaterMutexKey(t *testing.T) {
+	config := &Config{Project: "my-project"}
+	tests := []struct {
+		destination string
+		schema      map[string]*schema.Schema
+		raw         map[string]interface{}
+		producer    newResourceIamUpdaterFunc
+	}{
+		{"storage.googleapis.com/my-bucket", StorageBucketIamSchema, map[string]interface{}{"bucket": "my-bucket"}, StorageBucketIamUpdaterProducer},
+		{"bigquery.googleapis.com/projects/my-project/datasets/my_dataset", IamBigqueryDatasetSchema, map[string]interface{}{"project": "my-project", "dataset_id": "my_dataset"}, NewBigqueryDatasetIamUpdater},
+		{"pubsub.googleapis.com/projects/my-project/topics/my-topic", PubsubTopicIamSchema, map[string]interface{}{"project": "my-project", "topic": "my-topic"}, PubsubTopicIamUpdaterProducer},
+		{"logging.googleapis.com/projects/my-project/locations/global/buckets/my-bucket", IamProjectSchema, map[string]interface{}{"project": "my-project"}, NewProjectIamUpdater},
+	}
+
+	for _, test := range tests {
+		dest, err := parseLoggingSinkDestination(test.destination)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The writer permissions must be serialized with the IAM resources of the destination
+		iamUpdater, err := test.producer(schema.TestResourceDataRaw(t, test.schema, test.raw), config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := dest.iamUpdater(nil, config).GetMutexKey(), iamUpdater.GetMutexKey(); got != want {
+			t.Errorf("Mutex key mismatch on destination %#v: expected %#v but got %#v", test.destination, want, got)
+		}
+	}
+}
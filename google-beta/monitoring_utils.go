@@ -0,0 +1,266 @@
+package google
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v2"
+)
+
+// monitoringJsonNormalizer canonicalizes the JSON representation of a Cloud
+// Monitoring object so that two representations can be compared semantically.
+//
+// Field paths are dotted lists of field names, list elements are traversed
+// transparently. A path starting with "*." matches any path ending with the
+// rest of the pattern.
+type monitoringJsonNormalizer struct {
+	// Fields set by the API that are ignored when written
+	outputOnly []string
+	// Fields the API fills in with the given value when they're unset. Fields
+	// that aren't listed here are stripped when they hold their zero value.
+	defaults map[string]interface{}
+	// List fields whose order has no meaning
+	unordered []string
+}
+
+var monitoringDashboardJsonNormalizer = monitoringJsonNormalizer{
+	outputOnly: []string{"name", "etag"},
+	defaults: map[string]interface{}{
+		"*.dataSets.plotType":   "LINE",
+		"*.dataSets.targetAxis": "Y1",
+	},
+	unordered: []string{"mosaicLayout.tiles", "*.groupByFields"},
+}
+
+var monitoringAlertPolicyJsonNormalizer = monitoringJsonNormalizer{
+	outputOnly: []string{"name", "creationRecord", "mutationRecord", "conditions.name"},
+	defaults: map[string]interface{}{
+		"enabled": true,
+	},
+	unordered: []string{"notificationChannels", "*.groupByFields"},
+}
+
+func monitoringJsonPathMatches(patterns []string, path string) bool {
+	for _, p := range patterns {
+		if p == path || (strings.HasPrefix(p, "*.") && (path == p[2:] || strings.HasSuffix(path, p[1:]))) {
+			return true
+		}
+	}
+	return false
+}
+
+// stripOutputOnly removes the output only fields from a decoded object, leaving
+// everything else untouched.
+func (n monitoringJsonNormalizer) stripOutputOnly(v interface{}, path string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			p := joinMonitoringJsonPath(path, k)
+			if monitoringJsonPathMatches(n.outputOnly, p) {
+				continue
+			}
+			out[k] = n.stripOutputOnly(e, p)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, 0, len(v))
+		for _, e := range v {
+			out = append(out, n.stripOutputOnly(e, path))
+		}
+		return out
+	}
+	return v
+}
+
+// normalize strips output only fields and default values from a decoded
+// object and sorts its unordered lists.
+func (n monitoringJsonNormalizer) normalize(v interface{}, path string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			p := joinMonitoringJsonPath(path, k)
+			if monitoringJsonPathMatches(n.outputOnly, p) {
+				continue
+			}
+			ne := n.normalize(e, p)
+			if dv, ok := n.monitoringJsonDefault(p); ok {
+				if reflect.DeepEqual(ne, dv) {
+					continue
+				}
+			} else if isMonitoringJsonZero(ne) {
+				continue
+			}
+			out[k] = ne
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, 0, len(v))
+		for _, e := range v {
+			out = append(out, n.normalize(e, path))
+		}
+		if monitoringJsonPathMatches(n.unordered, path) {
+			sortMonitoringJsonList(out)
+		}
+		return out
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	case string:
+		// 64-bit integers are encoded as strings in the API's JSON
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return float64(i)
+		}
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	}
+	return v
+}
+
+func (n monitoringJsonNormalizer) monitoringJsonDefault(path string) (interface{}, bool) {
+	for p, v := range n.defaults {
+		if monitoringJsonPathMatches([]string{p}, path) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// diffSuppress returns a DiffSuppressFunc ignoring the differences between two
+// representations that normalize to the same object.
+func (n monitoringJsonNormalizer) diffSuppress() schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		oldObj, err := parseMonitoringJsonOrYaml(old)
+		if err != nil {
+			return false
+		}
+
+		newObj, err := parseMonitoringJsonOrYaml(new)
+		if err != nil {
+			return false
+		}
+
+		return reflect.DeepEqual(n.normalize(oldObj, ""), n.normalize(newObj, ""))
+	}
+}
+
+func joinMonitoringJsonPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func isMonitoringJsonZero(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func sortMonitoringJsonList(l []interface{}) {
+	keys := make(map[int]string, len(l))
+	for i, e := range l {
+		b, _ := json.Marshal(e)
+		keys[i] = string(b)
+	}
+	idx := make([]int, len(l))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return keys[idx[i]] < keys[idx[j]] })
+
+	sorted := make([]interface{}, len(l))
+	for i, j := range idx {
+		sorted[i] = l[j]
+	}
+	copy(l, sorted)
+}
+
+// parseMonitoringJsonOrYaml decodes an object exported from the Cloud Console
+// or gcloud, in either JSON or YAML.
+func parseMonitoringJsonOrYaml(s string) (map[string]interface{}, error) {
+	var obj map[string]interface{}
+
+	dec := json.NewDecoder(bytes.NewReader([]byte(s)))
+	dec.UseNumber()
+	jsonErr := dec.Decode(&obj)
+	if jsonErr == nil {
+		return obj, nil
+	}
+
+	var raw interface{}
+	if err := yaml.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, fmt.Errorf("value is neither valid JSON (%s) nor valid YAML (%s)", jsonErr, err)
+	}
+	obj, ok := convertMonitoringYaml(raw).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("value must be a JSON or YAML object")
+	}
+	return obj, nil
+}
+
+// convertMonitoringYaml converts the maps decoded by the yaml package, keyed
+// by interface{}, to maps keyed by string like the ones decoded from JSON.
+func convertMonitoringYaml(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			out[fmt.Sprintf("%v", k)] = convertMonitoringYaml(e)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, 0, len(v))
+		for _, e := range v {
+			out = append(out, convertMonitoringYaml(e))
+		}
+		return out
+	}
+	return v
+}
+
+func validateMonitoringJsonOrYaml(v interface{}, k string) (ws []string, errs []error) {
+	if _, err := parseMonitoringJsonOrYaml(v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
+
+// normalizeMonitoringJsonOrYaml converts JSON or YAML to compact JSON with
+// sorted keys, leaving the content untouched.
+func normalizeMonitoringJsonOrYaml(v interface{}) string {
+	obj, err := parseMonitoringJsonOrYaml(v.(string))
+	if err != nil {
+		return v.(string)
+	}
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return v.(string)
+	}
+	return string(b)
+}
@@ -0,0 +1,152 @@
+package google
+
+import (
+	"testing"
+)
+
+func TestMonitoringDashboardDiffSuppress(t *testing.T) {
+	cases := map[string]struct {
+		Old, New           string
+		ExpectDiffSuppress bool
+	}{
+		"same": {
+			Old:                `{"displayName":"foo"}`,
+			New:                `{"displayName":"foo"}`,
+			ExpectDiffSuppress: true,
+		},
+		"output only fields": {
+			Old:                `{"displayName":"foo","etag":"abc","name":"projects/1/dashboards/foo"}`,
+			New:                `{"displayName":"foo"}`,
+			ExpectDiffSuppress: true,
+		},
+		"server defaults": {
+			Old:                `{"displayName":"foo","gridLayout":{"columns":"0","widgets":[{"xyChart":{"dataSets":[{"plotType":"LINE","targetAxis":"Y1","legendTemplate":""}]}}]}}`,
+			New:                `{"displayName":"foo","gridLayout":{"widgets":[{"xyChart":{"dataSets":[{}]}}]}}`,
+			ExpectDiffSuppress: true,
+		},
+		"non default value": {
+			Old:                `{"displayName":"foo","gridLayout":{"widgets":[{"xyChart":{"dataSets":[{"plotType":"STACKED_BAR"}]}}]}}`,
+			New:                `{"displayName":"foo","gridLayout":{"widgets":[{"xyChart":{"dataSets":[{}]}}]}}`,
+			ExpectDiffSuppress: false,
+		},
+		"mosaic tiles reordered": {
+			Old:                `{"displayName":"foo","mosaicLayout":{"columns":2,"tiles":[{"xPos":1,"width":1},{"width":1}]}}`,
+			New:                `{"displayName":"foo","mosaicLayout":{"columns":2,"tiles":[{"width":1,"xPos":0},{"width":1,"xPos":1}]}}`,
+			ExpectDiffSuppress: true,
+		},
+		"grid widgets reordered": {
+			Old:                `{"displayName":"foo","gridLayout":{"widgets":[{"title":"a"},{"title":"b"}]}}`,
+			New:                `{"displayName":"foo","gridLayout":{"widgets":[{"title":"b"},{"title":"a"}]}}`,
+			ExpectDiffSuppress: false,
+		},
+		"display name changed": {
+			Old:                `{"displayName":"foo","etag":"abc"}`,
+			New:                `{"displayName":"bar"}`,
+			ExpectDiffSuppress: false,
+		},
+		"invalid": {
+			Old:                `{"displayName":"foo"}`,
+			New:                `[`,
+			ExpectDiffSuppress: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if monitoringDashboardDiffSuppress("dashboard_json", tc.Old, tc.New, nil) != tc.ExpectDiffSuppress {
+			t.Errorf("bad: %s, %q => %q expect DiffSuppress to return %t", tn, tc.Old, tc.New, tc.ExpectDiffSuppress)
+		}
+	}
+}
+
+func TestMonitoringAlertPolicyJsonDiffSuppress(t *testing.T) {
+	cases := map[string]struct {
+		Old, New           string
+		ExpectDiffSuppress bool
+	}{
+		"output only fields": {
+			Old:                `{"name":"projects/p/alertPolicies/1","displayName":"foo","combiner":"OR","creationRecord":{"mutateTime":"2022-01-01T00:00:00Z"},"mutationRecord":{"mutatedBy":"me"},"conditions":[{"name":"projects/p/alertPolicies/1/conditions/2","displayName":"bar"}]}`,
+			New:                `{"displayName":"foo","combiner":"OR","conditions":[{"displayName":"bar"}]}`,
+			ExpectDiffSuppress: true,
+		},
+		"enabled by default": {
+			Old:                `{"displayName":"foo","enabled":true}`,
+			New:                `{"displayName":"foo"}`,
+			ExpectDiffSuppress: true,
+		},
+		"disabled": {
+			Old:                `{"displayName":"foo","enabled":true}`,
+			New:                `{"displayName":"foo","enabled":false}`,
+			ExpectDiffSuppress: false,
+		},
+		"notification channels reordered": {
+			Old:                `{"displayName":"foo","notificationChannels":["a","b"]}`,
+			New:                `{"displayName":"foo","notificationChannels":["b","a"]}`,
+			ExpectDiffSuppress: true,
+		},
+		"numbers": {
+			Old:                `{"conditions":[{"conditionThreshold":{"thresholdValue":1,"trigger":{"count":1}}}]}`,
+			New:                `{"conditions":[{"conditionThreshold":{"thresholdValue":1.0,"trigger":{"count":1}}}]}`,
+			ExpectDiffSuppress: true,
+		},
+		"yaml": {
+			Old: `{"combiner":"OR","conditions":[{"conditionThreshold":{"comparison":"COMPARISON_GT","thresholdValue":0.8,"duration":"60s"},"displayName":"bar"}],"displayName":"foo","enabled":true}`,
+			New: `
+displayName: foo
+combiner: OR
+conditions:
+- displayName: bar
+  conditionThreshold:
+    comparison: COMPARISON_GT
+    duration: 60s
+    thresholdValue: 0.8
+`,
+			ExpectDiffSuppress: true,
+		},
+		"condition changed": {
+			Old:                `{"conditions":[{"conditionThreshold":{"thresholdValue":1}}]}`,
+			New:                `{"conditions":[{"conditionThreshold":{"thresholdValue":2}}]}`,
+			ExpectDiffSuppress: false,
+		},
+	}
+
+	suppress := monitoringAlertPolicyJsonNormalizer.diffSuppress()
+	for tn, tc := range cases {
+		if suppress("policy_json", tc.Old, tc.New, nil) != tc.ExpectDiffSuppress {
+			t.Errorf("bad: %s, %q => %q expect DiffSuppress to return %t", tn, tc.Old, tc.New, tc.ExpectDiffSuppress)
+		}
+	}
+}
+
+func TestParseMonitoringJsonOrYaml(t *testing.T) {
+	cases := map[string]struct {
+		Input     string
+		ExpectErr bool
+	}{
+		"json":   {Input: `{"displayName":"foo"}`},
+		"yaml":   {Input: "displayName: foo\nenabled: true\n"},
+		"list":   {Input: "- foo\n- bar\n", ExpectErr: true},
+		"scalar": {Input: "foo", ExpectErr: true},
+		"bad":    {Input: "foo: [", ExpectErr: true},
+	}
+
+	for tn, tc := range cases {
+		obj, err := parseMonitoringJsonOrYaml(tc.Input)
+		if tc.ExpectErr {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %#v", tn, obj)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if obj["displayName"] != "foo" {
+			t.Errorf("%s: expected displayName foo, got %#v", tn, obj)
+		}
+	}
+
+	if got, want := normalizeMonitoringJsonOrYaml("enabled: true\ndisplayName: foo\n"), `{"displayName":"foo","enabled":true}`; got != want {
+		t.Errorf("expected normalized %s, got %s", want, got)
+	}
+}
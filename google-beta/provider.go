@@ -148,6 +148,17 @@ func Provider() *schema.Provider {
 				}, nil),
 			},
 
+			"preflight_quota_check": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEnum([]string{"WARN", "FAIL"}),
+			},
+
+			"cidr_overlap_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			// Generated Products
 			"access_approval_custom_endpoint": {
 				Type:         schema.TypeString,
@@ -325,6 +336,14 @@ func Provider() *schema.Provider {
 					"GOOGLE_CLOUD_RUN_CUSTOM_ENDPOINT",
 				}, DefaultBasePaths[CloudRunBasePathKey]),
 			},
+			"cloud_run_v2_custom_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCustomEndpoint,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_CLOUD_RUN_V2_CUSTOM_ENDPOINT",
+				}, DefaultBasePaths[CloudRunV2BasePathKey]),
+			},
 			"cloud_scheduler_custom_endpoint": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -581,6 +600,14 @@ func Provider() *schema.Provider {
 					"GOOGLE_NETWORK_MANAGEMENT_CUSTOM_ENDPOINT",
 				}, DefaultBasePaths[NetworkManagementBasePathKey]),
 			},
+			"network_security_custom_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCustomEndpoint,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_NETWORK_SECURITY_CUSTOM_ENDPOINT",
+				}, DefaultBasePaths[NetworkSecurityBasePathKey]),
+			},
 			"network_services_custom_endpoint": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -845,8 +872,10 @@ func Provider() *schema.Provider {
 			"google_compute_network":                              dataSourceGoogleComputeNetwork(),
 			"google_compute_network_endpoint_group":               dataSourceGoogleComputeNetworkEndpointGroup(),
 			"google_compute_node_types":                           dataSourceGoogleComputeNodeTypes(),
+			"google_compute_project_quota":                        dataSourceGoogleComputeProjectQuota(),
 			"google_compute_regions":                              dataSourceGoogleComputeRegions(),
 			"google_compute_region_instance_group":                dataSourceGoogleComputeRegionInstanceGroup(),
+			"google_compute_region_quota":                         dataSourceGoogleComputeRegionQuota(),
 			"google_compute_region_ssl_certificate":               dataSourceGoogleRegionComputeSslCertificate(),
 			"google_compute_resource_policy":                      dataSourceGoogleComputeResourcePolicy(),
 			"google_compute_router":                               dataSourceGoogleComputeRouter(),
@@ -875,6 +904,8 @@ func Provider() *schema.Provider {
 			"google_iap_client":                                   dataSourceGoogleIapClient(),
 			"google_kms_crypto_key":                               dataSourceGoogleKmsCryptoKey(),
 			"google_kms_crypto_key_version":                       dataSourceGoogleKmsCryptoKeyVersion(),
+			"google_kms_crypto_key_versions":                      dataSourceGoogleKmsCryptoKeyVersions(),
+			"google_kms_crypto_keys":                              dataSourceGoogleKmsCryptoKeys(),
 			"google_kms_key_ring":                                 dataSourceGoogleKmsKeyRing(),
 			"google_kms_secret":                                   dataSourceGoogleKmsSecret(),
 			"google_kms_secret_ciphertext":                        dataSourceGoogleKmsSecretCiphertext(),
@@ -897,6 +928,7 @@ func Provider() *schema.Provider {
 			"google_projects":                                     dataSourceGoogleProjects(),
 			"google_project_organization_policy":                  dataSourceGoogleProjectOrganizationPolicy(),
 			"google_pubsub_topic":                                 dataSourceGooglePubsubTopic(),
+			"google_pubsub_schema_message_validation":             dataSourceGooglePubsubSchemaMessageValidation(),
 			"google_runtimeconfig_config":                         dataSourceGoogleRuntimeconfigConfig(),
 			"google_runtimeconfig_variable":                       dataSourceGoogleRuntimeconfigVariable(),
 			"google_secret_manager_secret":                        dataSourceSecretManagerSecret(),
@@ -915,7 +947,9 @@ func Provider() *schema.Provider {
 			"google_storage_bucket":                               dataSourceGoogleStorageBucket(),
 			"google_storage_bucket_object":                        dataSourceGoogleStorageBucketObject(),
 			"google_storage_bucket_object_content":                dataSourceGoogleStorageBucketObjectContent(),
+			"google_storage_bucket_objects":                       dataSourceGoogleStorageBucketObjects(),
 			"google_storage_object_signed_url":                    dataSourceGoogleSignedUrl(),
+			"google_storage_object_signed_post_policy":            dataSourceGoogleStorageObjectSignedPostPolicy(),
 			"google_storage_project_service_account":              dataSourceGoogleStorageProjectServiceAccount(),
 			"google_storage_transfer_project_service_account":     dataSourceGoogleStorageTransferProjectServiceAccount(),
 			"google_tags_tag_key":                                 dataSourceGoogleTagsTagKey(),
@@ -936,9 +970,9 @@ func Provider() *schema.Provider {
 	return provider
 }
 
-// Generated resources: 256
-// Generated IAM resources: 168
-// Total generated resources: 424
+// Generated resources: 259
+// Generated IAM resources: 174
+// Total generated resources: 433
 func ResourceMap() map[string]*schema.Resource {
 	resourceMap, _ := ResourceMapWithErrors()
 	return resourceMap
@@ -1044,6 +1078,14 @@ func ResourceMapWithErrors() (map[string]*schema.Resource, error) {
 			"google_cloud_run_service_iam_binding":                         ResourceIamBinding(CloudRunServiceIamSchema, CloudRunServiceIamUpdaterProducer, CloudRunServiceIdParseFunc),
 			"google_cloud_run_service_iam_member":                          ResourceIamMember(CloudRunServiceIamSchema, CloudRunServiceIamUpdaterProducer, CloudRunServiceIdParseFunc),
 			"google_cloud_run_service_iam_policy":                          ResourceIamPolicy(CloudRunServiceIamSchema, CloudRunServiceIamUpdaterProducer, CloudRunServiceIdParseFunc),
+			"google_cloud_run_v2_job":                                      resourceCloudRunV2Job(),
+			"google_cloud_run_v2_job_iam_binding":                          ResourceIamBinding(CloudRunV2JobIamSchema, CloudRunV2JobIamUpdaterProducer, CloudRunV2JobIdParseFunc),
+			"google_cloud_run_v2_job_iam_member":                           ResourceIamMember(CloudRunV2JobIamSchema, CloudRunV2JobIamUpdaterProducer, CloudRunV2JobIdParseFunc),
+			"google_cloud_run_v2_job_iam_policy":                           ResourceIamPolicy(CloudRunV2JobIamSchema, CloudRunV2JobIamUpdaterProducer, CloudRunV2JobIdParseFunc),
+			"google_cloud_run_v2_service":                                  resourceCloudRunV2Service(),
+			"google_cloud_run_v2_service_iam_binding":                      ResourceIamBinding(CloudRunV2ServiceIamSchema, CloudRunV2ServiceIamUpdaterProducer, CloudRunV2ServiceIdParseFunc),
+			"google_cloud_run_v2_service_iam_member":                       ResourceIamMember(CloudRunV2ServiceIamSchema, CloudRunV2ServiceIamUpdaterProducer, CloudRunV2ServiceIdParseFunc),
+			"google_cloud_run_v2_service_iam_policy":                       ResourceIamPolicy(CloudRunV2ServiceIamSchema, CloudRunV2ServiceIamUpdaterProducer, CloudRunV2ServiceIdParseFunc),
 			"google_cloud_scheduler_job":                                   resourceCloudSchedulerJob(),
 			"google_cloud_tasks_queue":                                     resourceCloudTasksQueue(),
 			"google_cloud_tasks_queue_iam_binding":                         ResourceIamBinding(CloudTasksQueueIamSchema, CloudTasksQueueIamUpdaterProducer, CloudTasksQueueIdParseFunc),
@@ -1264,6 +1306,7 @@ func ResourceMapWithErrors() (map[string]*schema.Resource, error) {
 			"google_identity_platform_tenant":                              resourceIdentityPlatformTenant(),
 			"google_kms_key_ring":                                          resourceKMSKeyRing(),
 			"google_kms_crypto_key":                                        resourceKMSCryptoKey(),
+			"google_kms_crypto_key_version":                                resourceKMSCryptoKeyVersion(),
 			"google_kms_key_ring_import_job":                               resourceKMSKeyRingImportJob(),
 			"google_kms_secret_ciphertext":                                 resourceKMSSecretCiphertext(),
 			"google_logging_metric":                                        resourceLoggingMetric(),
@@ -1277,6 +1320,7 @@ func ResourceMapWithErrors() (map[string]*schema.Resource, error) {
 			"google_monitoring_uptime_check_config":                        resourceMonitoringUptimeCheckConfig(),
 			"google_monitoring_metric_descriptor":                          resourceMonitoringMetricDescriptor(),
 			"google_network_management_connectivity_test":                  resourceNetworkManagementConnectivityTest(),
+			"google_network_security_address_group":                        resourceNetworkSecurityAddressGroup(),
 			"google_network_services_edge_cache_keyset":                    resourceNetworkServicesEdgeCacheKeyset(),
 			"google_network_services_edge_cache_origin":                    resourceNetworkServicesEdgeCacheOrigin(),
 			"google_network_services_edge_cache_service":                   resourceNetworkServicesEdgeCacheService(),
@@ -1374,80 +1418,91 @@ func ResourceMapWithErrors() (map[string]*schema.Resource, error) {
 		},
 		map[string]*schema.Resource{
 			// ####### START handwritten resources ###########
-			"google_app_engine_application":                resourceAppEngineApplication(),
-			"google_bigquery_table":                        resourceBigQueryTable(),
-			"google_bigtable_gc_policy":                    resourceBigtableGCPolicy(),
-			"google_bigtable_instance":                     resourceBigtableInstance(),
-			"google_bigtable_table":                        resourceBigtableTable(),
-			"google_billing_subaccount":                    resourceBillingSubaccount(),
-			"google_cloudfunctions_function":               resourceCloudFunctionsFunction(),
-			"google_composer_environment":                  resourceComposerEnvironment(),
-			"google_compute_attached_disk":                 resourceComputeAttachedDisk(),
-			"google_compute_instance":                      resourceComputeInstance(),
-			"google_compute_instance_from_machine_image":   resourceComputeInstanceFromMachineImage(),
-			"google_compute_instance_from_template":        resourceComputeInstanceFromTemplate(),
-			"google_compute_instance_group":                resourceComputeInstanceGroup(),
-			"google_compute_instance_group_manager":        resourceComputeInstanceGroupManager(),
-			"google_compute_instance_template":             resourceComputeInstanceTemplate(),
-			"google_compute_network_peering":               resourceComputeNetworkPeering(),
-			"google_compute_project_default_network_tier":  resourceComputeProjectDefaultNetworkTier(),
-			"google_compute_project_metadata":              resourceComputeProjectMetadata(),
-			"google_compute_project_metadata_item":         resourceComputeProjectMetadataItem(),
-			"google_compute_region_instance_group_manager": resourceComputeRegionInstanceGroupManager(),
-			"google_compute_router_interface":              resourceComputeRouterInterface(),
-			"google_compute_security_policy":               resourceComputeSecurityPolicy(),
-			"google_compute_shared_vpc_host_project":       resourceComputeSharedVpcHostProject(),
-			"google_compute_shared_vpc_service_project":    resourceComputeSharedVpcServiceProject(),
-			"google_compute_target_pool":                   resourceComputeTargetPool(),
-			"google_container_cluster":                     resourceContainerCluster(),
-			"google_container_node_pool":                   resourceContainerNodePool(),
-			"google_container_registry":                    resourceContainerRegistry(),
-			"google_dataflow_job":                          resourceDataflowJob(),
-			"google_dataflow_flex_template_job":            resourceDataflowFlexTemplateJob(),
-			"google_dataproc_cluster":                      resourceDataprocCluster(),
-			"google_dataproc_job":                          resourceDataprocJob(),
-			"google_dns_record_set":                        resourceDnsRecordSet(),
-			"google_endpoints_service":                     resourceEndpointsService(),
-			"google_folder":                                resourceGoogleFolder(),
-			"google_folder_organization_policy":            resourceGoogleFolderOrganizationPolicy(),
-			"google_logging_billing_account_sink":          resourceLoggingBillingAccountSink(),
-			"google_logging_billing_account_exclusion":     ResourceLoggingExclusion(BillingAccountLoggingExclusionSchema, NewBillingAccountLoggingExclusionUpdater, billingAccountLoggingExclusionIdParseFunc),
-			"google_logging_billing_account_bucket_config": ResourceLoggingBillingAccountBucketConfig(),
-			"google_logging_organization_sink":             resourceLoggingOrganizationSink(),
-			"google_logging_organization_exclusion":        ResourceLoggingExclusion(OrganizationLoggingExclusionSchema, NewOrganizationLoggingExclusionUpdater, organizationLoggingExclusionIdParseFunc),
-			"google_logging_organization_bucket_config":    ResourceLoggingOrganizationBucketConfig(),
-			"google_logging_folder_sink":                   resourceLoggingFolderSink(),
-			"google_logging_folder_exclusion":              ResourceLoggingExclusion(FolderLoggingExclusionSchema, NewFolderLoggingExclusionUpdater, folderLoggingExclusionIdParseFunc),
-			"google_logging_folder_bucket_config":          ResourceLoggingFolderBucketConfig(),
-			"google_logging_project_sink":                  resourceLoggingProjectSink(),
-			"google_logging_project_exclusion":             ResourceLoggingExclusion(ProjectLoggingExclusionSchema, NewProjectLoggingExclusionUpdater, projectLoggingExclusionIdParseFunc),
-			"google_logging_project_bucket_config":         ResourceLoggingProjectBucketConfig(),
-			"google_monitoring_dashboard":                  resourceMonitoringDashboard(),
-			"google_project_service_identity":              resourceProjectServiceIdentity(),
-			"google_service_networking_connection":         resourceServiceNetworkingConnection(),
-			"google_sql_database_instance":                 resourceSqlDatabaseInstance(),
-			"google_sql_ssl_cert":                          resourceSqlSslCert(),
-			"google_sql_user":                              resourceSqlUser(),
-			"google_organization_iam_custom_role":          resourceGoogleOrganizationIamCustomRole(),
-			"google_organization_policy":                   resourceGoogleOrganizationPolicy(),
-			"google_project":                               resourceGoogleProject(),
-			"google_project_default_service_accounts":      resourceGoogleProjectDefaultServiceAccounts(),
-			"google_project_service":                       resourceGoogleProjectService(),
-			"google_project_iam_custom_role":               resourceGoogleProjectIamCustomRole(),
-			"google_project_organization_policy":           resourceGoogleProjectOrganizationPolicy(),
-			"google_project_usage_export_bucket":           resourceProjectUsageBucket(),
-			"google_runtimeconfig_config":                  resourceRuntimeconfigConfig(),
-			"google_runtimeconfig_variable":                resourceRuntimeconfigVariable(),
-			"google_service_account":                       resourceGoogleServiceAccount(),
-			"google_service_account_key":                   resourceGoogleServiceAccountKey(),
-			"google_service_networking_peered_dns_domain":  resourceGoogleServiceNetworkingPeeredDNSDomain(),
-			"google_storage_bucket":                        resourceStorageBucket(),
-			"google_storage_bucket_acl":                    resourceStorageBucketAcl(),
-			"google_storage_bucket_object":                 resourceStorageBucketObject(),
-			"google_storage_object_acl":                    resourceStorageObjectAcl(),
-			"google_storage_default_object_acl":            resourceStorageDefaultObjectAcl(),
-			"google_storage_notification":                  resourceStorageNotification(),
-			"google_storage_transfer_job":                  resourceStorageTransferJob(),
+			"google_app_engine_application":                             resourceAppEngineApplication(),
+			"google_bigquery_table":                                     resourceBigQueryTable(),
+			"google_bigtable_gc_policy":                                 resourceBigtableGCPolicy(),
+			"google_bigtable_instance":                                  resourceBigtableInstance(),
+			"google_bigtable_table":                                     resourceBigtableTable(),
+			"google_billing_subaccount":                                 resourceBillingSubaccount(),
+			"google_cloudfunctions_function":                            resourceCloudFunctionsFunction(),
+			"google_composer_environment":                               resourceComposerEnvironment(),
+			"google_compute_attached_disk":                              resourceComputeAttachedDisk(),
+			"google_compute_disk_async_replication":                     resourceComputeDiskAsyncReplication(),
+			"google_compute_firewall_policy_rule":                       resourceComputeFirewallPolicyRule(),
+			"google_compute_instance":                                   resourceComputeInstance(),
+			"google_compute_instance_from_machine_image":                resourceComputeInstanceFromMachineImage(),
+			"google_compute_instance_from_template":                     resourceComputeInstanceFromTemplate(),
+			"google_compute_instance_group":                             resourceComputeInstanceGroup(),
+			"google_compute_instance_group_manager":                     resourceComputeInstanceGroupManager(),
+			"google_compute_instance_template":                          resourceComputeInstanceTemplate(),
+			"google_compute_network_firewall_policy":                    resourceComputeNetworkFirewallPolicy(),
+			"google_compute_network_firewall_policy_association":        resourceComputeNetworkFirewallPolicyAssociation(),
+			"google_compute_network_firewall_policy_rule":               resourceComputeNetworkFirewallPolicyRule(),
+			"google_compute_network_peering":                            resourceComputeNetworkPeering(),
+			"google_compute_project_default_network_tier":               resourceComputeProjectDefaultNetworkTier(),
+			"google_compute_project_metadata":                           resourceComputeProjectMetadata(),
+			"google_compute_project_metadata_item":                      resourceComputeProjectMetadataItem(),
+			"google_compute_region_instance_group_manager":              resourceComputeRegionInstanceGroupManager(),
+			"google_compute_region_network_firewall_policy":             resourceComputeRegionNetworkFirewallPolicy(),
+			"google_compute_region_network_firewall_policy_association": resourceComputeRegionNetworkFirewallPolicyAssociation(),
+			"google_compute_region_network_firewall_policy_rule":        resourceComputeRegionNetworkFirewallPolicyRule(),
+			"google_compute_router_interface":                           resourceComputeRouterInterface(),
+			"google_compute_router_nat_address":                         resourceComputeRouterNatAddress(),
+			"google_compute_security_policy":                            resourceComputeSecurityPolicy(),
+			"google_compute_shared_vpc_host_project":                    resourceComputeSharedVpcHostProject(),
+			"google_compute_shared_vpc_service_project":                 resourceComputeSharedVpcServiceProject(),
+			"google_compute_target_pool":                                resourceComputeTargetPool(),
+			"google_container_cluster":                                  resourceContainerCluster(),
+			"google_container_node_pool":                                resourceContainerNodePool(),
+			"google_container_registry":                                 resourceContainerRegistry(),
+			"google_dataflow_job":                                       resourceDataflowJob(),
+			"google_dataflow_flex_template_job":                         resourceDataflowFlexTemplateJob(),
+			"google_dataproc_cluster":                                   resourceDataprocCluster(),
+			"google_dataproc_job":                                       resourceDataprocJob(),
+			"google_dns_record_set":                                     resourceDnsRecordSet(),
+			"google_dns_managed_zone_records":                           resourceDnsManagedZoneRecords(),
+			"google_endpoints_service":                                  resourceEndpointsService(),
+			"google_folder":                                             resourceGoogleFolder(),
+			"google_folder_organization_policy":                         resourceGoogleFolderOrganizationPolicy(),
+			"google_logging_billing_account_sink":                       resourceLoggingBillingAccountSink(),
+			"google_logging_billing_account_exclusion":                  ResourceLoggingExclusion(BillingAccountLoggingExclusionSchema, NewBillingAccountLoggingExclusionUpdater, billingAccountLoggingExclusionIdParseFunc),
+			"google_logging_billing_account_bucket_config":              ResourceLoggingBillingAccountBucketConfig(),
+			"google_logging_organization_sink":                          resourceLoggingOrganizationSink(),
+			"google_logging_organization_exclusion":                     ResourceLoggingExclusion(OrganizationLoggingExclusionSchema, NewOrganizationLoggingExclusionUpdater, organizationLoggingExclusionIdParseFunc),
+			"google_logging_organization_bucket_config":                 ResourceLoggingOrganizationBucketConfig(),
+			"google_logging_folder_sink":                                resourceLoggingFolderSink(),
+			"google_logging_folder_exclusion":                           ResourceLoggingExclusion(FolderLoggingExclusionSchema, NewFolderLoggingExclusionUpdater, folderLoggingExclusionIdParseFunc),
+			"google_logging_folder_bucket_config":                       ResourceLoggingFolderBucketConfig(),
+			"google_logging_project_sink":                               resourceLoggingProjectSink(),
+			"google_logging_project_exclusion":                          ResourceLoggingExclusion(ProjectLoggingExclusionSchema, NewProjectLoggingExclusionUpdater, projectLoggingExclusionIdParseFunc),
+			"google_logging_project_bucket_config":                      ResourceLoggingProjectBucketConfig(),
+			"google_monitoring_dashboard":                               resourceMonitoringDashboard(),
+			"google_project_service_identity":                           resourceProjectServiceIdentity(),
+			"google_service_networking_connection":                      resourceServiceNetworkingConnection(),
+			"google_sql_database_instance":                              resourceSqlDatabaseInstance(),
+			"google_sql_ssl_cert":                                       resourceSqlSslCert(),
+			"google_sql_user":                                           resourceSqlUser(),
+			"google_organization_iam_custom_role":                       resourceGoogleOrganizationIamCustomRole(),
+			"google_organization_policy":                                resourceGoogleOrganizationPolicy(),
+			"google_project":                                            resourceGoogleProject(),
+			"google_project_default_service_accounts":                   resourceGoogleProjectDefaultServiceAccounts(),
+			"google_project_service":                                    resourceGoogleProjectService(),
+			"google_project_iam_custom_role":                            resourceGoogleProjectIamCustomRole(),
+			"google_project_organization_policy":                        resourceGoogleProjectOrganizationPolicy(),
+			"google_project_usage_export_bucket":                        resourceProjectUsageBucket(),
+			"google_runtimeconfig_config":                               resourceRuntimeconfigConfig(),
+			"google_runtimeconfig_variable":                             resourceRuntimeconfigVariable(),
+			"google_service_account":                                    resourceGoogleServiceAccount(),
+			"google_service_account_key":                                resourceGoogleServiceAccountKey(),
+			"google_service_networking_peered_dns_domain":               resourceGoogleServiceNetworkingPeeredDNSDomain(),
+			"google_storage_bucket":                                     resourceStorageBucket(),
+			"google_storage_bucket_acl":                                 resourceStorageBucketAcl(),
+			"google_storage_bucket_object":                              resourceStorageBucketObject(),
+			"google_storage_bucket_directory_sync":                      resourceStorageBucketDirectorySync(),
+			"google_storage_object_acl":                                 resourceStorageObjectAcl(),
+			"google_storage_default_object_acl":                         resourceStorageDefaultObjectAcl(),
+			"google_storage_notification":                               resourceStorageNotification(),
+			"google_storage_transfer_job":                               resourceStorageTransferJob(),
 			// ####### END handwritten resources ###########
 		},
 		map[string]*schema.Resource{
@@ -1546,6 +1601,12 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		config.RequestReason = v.(string)
 	}
 
+	if v, ok := d.GetOk("preflight_quota_check"); ok {
+		config.quotaPreflight = newComputeQuotaPreflight(v.(string))
+	}
+
+	config.cidrOverlapCheck = d.Get("cidr_overlap_check").(bool)
+
 	// Check for primary credentials in config. Note that if neither is set, ADCs
 	// will be used if available.
 	if v, ok := d.GetOk("access_token"); ok {
@@ -1621,6 +1682,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	config.CloudIdentityBasePath = d.Get("cloud_identity_custom_endpoint").(string)
 	config.CloudIotBasePath = d.Get("cloud_iot_custom_endpoint").(string)
 	config.CloudRunBasePath = d.Get("cloud_run_custom_endpoint").(string)
+	config.CloudRunV2BasePath = d.Get("cloud_run_v2_custom_endpoint").(string)
 	config.CloudSchedulerBasePath = d.Get("cloud_scheduler_custom_endpoint").(string)
 	config.CloudTasksBasePath = d.Get("cloud_tasks_custom_endpoint").(string)
 	config.ComputeBasePath = d.Get("compute_custom_endpoint").(string)
@@ -1653,6 +1715,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	config.MLEngineBasePath = d.Get("ml_engine_custom_endpoint").(string)
 	config.MonitoringBasePath = d.Get("monitoring_custom_endpoint").(string)
 	config.NetworkManagementBasePath = d.Get("network_management_custom_endpoint").(string)
+	config.NetworkSecurityBasePath = d.Get("network_security_custom_endpoint").(string)
 	config.NetworkServicesBasePath = d.Get("network_services_custom_endpoint").(string)
 	config.NotebooksBasePath = d.Get("notebooks_custom_endpoint").(string)
 	config.OSConfigBasePath = d.Get("os_config_custom_endpoint").(string)
@@ -28,7 +28,6 @@ var dclResources = map[string]*schema.Resource{
 	"google_clouddeploy_target":                  resourceClouddeployTarget(),
 	"google_compute_firewall_policy":             resourceComputeFirewallPolicy(),
 	"google_compute_firewall_policy_association": resourceComputeFirewallPolicyAssociation(),
-	"google_compute_firewall_policy_rule":        resourceComputeFirewallPolicyRule(),
 	"google_container_aws_cluster":               resourceContainerAwsCluster(),
 	"google_container_aws_node_pool":             resourceContainerAwsNodePool(),
 	"google_container_azure_client":              resourceContainerAzureClient(),
@@ -1,8 +1,11 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 const PubsubTopicRegex = "projects\\/.*\\/topics\\/.*"
@@ -22,3 +25,58 @@ func getComputedTopicName(project, topic string) string {
 	}
 	return fmt.Sprintf("projects/%s/topics/%s", project, topic)
 }
+
+// findPubsubSchemaRevisionWithDefinition returns the ID of the most recent
+// revision of the schema with the given type and definition, or an empty
+// string if there is none.
+func findPubsubSchemaRevisionWithDefinition(d TerraformResourceData, config *Config, billingProject, userAgent, schemaType, definition string) (string, error) {
+	params := map[string]string{"view": "FULL"}
+	for {
+		url, err := replaceVars(d, config, "{{PubsubBasePath}}projects/{{project}}/schemas/{{name}}:listRevisions")
+		if err != nil {
+			return "", err
+		}
+		url, err = addQueryParams(url, params)
+		if err != nil {
+			return "", err
+		}
+
+		res, err := sendRequest(config, "GET", billingProject, url, userAgent, nil)
+		if err != nil {
+			return "", fmt.Errorf("Error listing revisions of Schema %q: %s", d.Id(), err)
+		}
+
+		// Revisions are listed from the most recent to the oldest
+		revisions, _ := res["schemas"].([]interface{})
+		for _, raw := range revisions {
+			revision, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if revision["type"] == schemaType && revision["definition"] == definition {
+				if id, ok := revision["revisionId"].(string); ok {
+					return id, nil
+				}
+			}
+		}
+
+		pToken, ok := res["nextPageToken"]
+		if ok && pToken != nil && pToken.(string) != "" {
+			params["pageToken"] = pToken.(string)
+		} else {
+			return "", nil
+		}
+	}
+}
+
+// resourcePubsubSchemaRevisionCustomizeDiff marks the revision as changing when
+// the definition does, as a new revision is committed for it.
+func resourcePubsubSchemaRevisionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("definition") {
+		return nil
+	}
+	if err := d.SetNewComputed("revision_id"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("revision_create_time")
+}
@@ -10,8 +10,10 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -202,9 +204,113 @@ func bigQueryTableModeIsForceNew(old, new string) bool {
 	return !eq && !reqToNull
 }
 
+// Types that a column can be widened to in place, keyed by the column's
+// current type. https://cloud.google.com/bigquery/docs/managing-table-schemas#change_a_columns_data_type
+var bigQueryTableWidenableTypes = map[string][]string{
+	"INT64":   {"NUMERIC", "BIGNUMERIC"},
+	"NUMERIC": {"BIGNUMERIC"},
+}
+
+func bigQueryTableNormalizeType(t string) string {
+	switch t := strings.ToUpper(t); t {
+	case "INTEGER":
+		return "INT64"
+	case "DECIMAL":
+		return "NUMERIC"
+	case "BIGDECIMAL":
+		return "BIGNUMERIC"
+	default:
+		return t
+	}
+}
+
+func bigQueryTableTypeIsWidening(old, new string) bool {
+	for _, t := range bigQueryTableWidenableTypes[bigQueryTableNormalizeType(old)] {
+		if t == bigQueryTableNormalizeType(new) {
+			return true
+		}
+	}
+	return false
+}
+
+// bigQueryTableSchemaChanges records the changes to top-level columns that
+// can't be made through a tables.update call and are instead applied with
+// DDL statements before the table is updated.
+type bigQueryTableSchemaChanges struct {
+	droppedColumns []string
+	// pairs of [old name, new name]
+	renamedColumns [][2]string
+	// pairs of [column name, new type]
+	widenedColumns [][2]string
+}
+
+func (c *bigQueryTableSchemaChanges) isEmpty() bool {
+	return len(c.droppedColumns) == 0 && len(c.renamedColumns) == 0 && len(c.widenedColumns) == 0
+}
+
+// ddlActions returns the ALTER TABLE actions applying the changes to the
+// table, in the order they need to be run.
+func (c *bigQueryTableSchemaChanges) ddlActions() []string {
+	var actions []string
+	for _, column := range c.droppedColumns {
+		actions = append(actions, fmt.Sprintf("DROP COLUMN `%s`", column))
+	}
+	for _, rename := range c.renamedColumns {
+		actions = append(actions, fmt.Sprintf("RENAME COLUMN `%s` TO `%s`", rename[0], rename[1]))
+	}
+	for _, widen := range c.widenedColumns {
+		actions = append(actions, fmt.Sprintf("ALTER COLUMN `%s` SET DATA TYPE %s", widen[0], bigQueryTableNormalizeType(widen[1])))
+	}
+	return actions
+}
+
+// ddlStatements returns the statements applying the changes to the table,
+// in the order they need to be run.
+func (c *bigQueryTableSchemaChanges) ddlStatements(project, dataset, table string) []string {
+	tableRef := fmt.Sprintf("`%s.%s.%s`", project, dataset, table)
+	var statements []string
+	for _, action := range c.ddlActions() {
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s %s", tableRef, action))
+	}
+	return statements
+}
+
+// bigQueryTableColumnRenames returns the column renames requested through
+// column_renames, keyed by the old column name.
+func bigQueryTableColumnRenames(d interface {
+	Get(string) interface{}
+}) map[string]string {
+	renames := make(map[string]string)
+	if m, ok := d.Get("column_renames").(map[string]interface{}); ok {
+		for oldName, newName := range m {
+			renames[oldName] = newName.(string)
+		}
+	}
+	return renames
+}
+
 // Compares two existing schema implementations and decides if
 // it is changeable.. pairs with a force new on not changeable
 func resourceBigQueryTableSchemaIsChangeable(old, new interface{}) (bool, error) {
+	_, isChangeable, err := resourceBigQueryTableSchemaChanges(old, new, true, nil)
+	return isChangeable, err
+}
+
+// resourceBigQueryTableSchemaChanges decides if the schema can be changed in
+// place and returns the DDL changes that requires. Columns can only be
+// dropped, renamed or widened when allowDDL is set, which is the case for
+// native tables. Columns are only renamed as requested by renames, keyed by
+// their old name.
+func resourceBigQueryTableSchemaChanges(old, new interface{}, allowDDL bool, renames map[string]string) (*bigQueryTableSchemaChanges, bool, error) {
+	changes := &bigQueryTableSchemaChanges{}
+	isChangeable, err := bigQueryTableSchemaIsChangeable(old, new, allowDDL, renames, changes)
+	if err != nil || !isChangeable {
+		return nil, false, err
+	}
+	return changes, true, nil
+}
+
+func bigQueryTableSchemaIsChangeable(old, new interface{}, topLevel bool, renames map[string]string, changes *bigQueryTableSchemaChanges) (bool, error) {
 	switch old.(type) {
 	case []interface{}:
 		arrayOld := old.([]interface{})
@@ -213,7 +319,7 @@ func resourceBigQueryTableSchemaIsChangeable(old, new interface{}) (bool, error)
 			// if not both arrays not changeable
 			return false, nil
 		}
-		if len(arrayOld) > len(arrayNew) {
+		if !topLevel && len(arrayOld) > len(arrayNew) {
 			// if not growing not changeable
 			return false, nil
 		}
@@ -225,9 +331,39 @@ func resourceBigQueryTableSchemaIsChangeable(old, new interface{}) (bool, error)
 			return false, err
 		}
 		mapNew := bigQueryArrayToMapIndexedByName(arrayNew)
+
+		// A top-level column that's gone from the schema is renamed rather than
+		// dropped only when the rename is requested explicitly and the new name
+		// is new to the schema. Renames that were already made are ignored.
+		renamed := make(map[string]string)
+		if topLevel {
+			for _, item := range arrayOld {
+				oldName := item.(map[string]interface{})["name"].(string)
+				newName, ok := renames[oldName]
+				if !ok {
+					continue
+				}
+				if _, ok := mapNew[oldName]; ok {
+					continue
+				}
+				if _, ok := mapOld[newName]; ok {
+					continue
+				}
+				if _, ok := mapNew[newName]; !ok {
+					continue
+				}
+				renamed[oldName] = newName
+				changes.renamedColumns = append(changes.renamedColumns, [2]string{oldName, newName})
+			}
+		}
+		renamedTo := make(map[string]bool)
+		for _, newName := range renamed {
+			renamedTo[newName] = true
+		}
+
 		for key := range mapNew {
 			// making unchangeable if an newly added column is with REQUIRED mode
-			if _, ok := mapOld[key]; !ok {
+			if _, ok := mapOld[key]; !ok && !renamedTo[key] {
 				items := mapNew[key].(map[string]interface{})
 				for k := range items {
 					if k == "mode" && fmt.Sprintf("%v", items[k]) == "REQUIRED" {
@@ -236,13 +372,32 @@ func resourceBigQueryTableSchemaIsChangeable(old, new interface{}) (bool, error)
 				}
 			}
 		}
-		for key := range mapOld {
-			// all old keys should be represented in the new config
+		for _, item := range arrayOld {
+			key := item.(map[string]interface{})["name"].(string)
+			if newName, ok := renamed[key]; ok {
+				// The rest of the column definition must be changeable as well
+				oldColumn := make(map[string]interface{})
+				for k, v := range item.(map[string]interface{}) {
+					oldColumn[k] = v
+				}
+				oldColumn["name"] = newName
+				if isChangable, err :=
+					bigQueryTableSchemaIsChangeable(oldColumn, mapNew[newName], topLevel, nil, changes); err != nil || !isChangable {
+					return false, err
+				}
+				continue
+			}
+			// all old keys should be represented in the new config, unless
+			// they're top-level columns that can be dropped
 			if _, ok := mapNew[key]; !ok {
-				return false, nil
+				if !topLevel {
+					return false, nil
+				}
+				changes.droppedColumns = append(changes.droppedColumns, key)
+				continue
 			}
 			if isChangable, err :=
-				resourceBigQueryTableSchemaIsChangeable(mapOld[key], mapNew[key]); err != nil || !isChangable {
+				bigQueryTableSchemaIsChangeable(mapOld[key], mapNew[key], topLevel, nil, changes); err != nil || !isChangable {
 				return false, err
 			}
 		}
@@ -274,9 +429,14 @@ func resourceBigQueryTableSchemaIsChangeable(old, new interface{}) (bool, error)
 					// This is invalid, so it shouldn't require a ForceNew
 					return true, nil
 				}
-				if !bigQueryTableTypeEq(valOld.(string), valNew.(string)) {
+				if bigQueryTableTypeEq(valOld.(string), valNew.(string)) {
+					continue
+				}
+				// Only top-level, non-repeated columns can have their type widened
+				if !topLevel || bigQueryTableNormalizeMode(objectNew["mode"]) == "REPEATED" || !bigQueryTableTypeIsWidening(valOld.(string), valNew.(string)) {
 					return false, nil
 				}
+				changes.widenedColumns = append(changes.widenedColumns, [2]string{objectNew["name"].(string), valNew.(string)})
 			case "mode":
 				if bigQueryTableModeIsForceNew(
 					bigQueryTableNormalizeMode(valOld),
@@ -285,10 +445,13 @@ func resourceBigQueryTableSchemaIsChangeable(old, new interface{}) (bool, error)
 					return false, nil
 				}
 			case "fields":
-				return resourceBigQueryTableSchemaIsChangeable(valOld, valNew)
+				// nested fields can't be dropped, renamed or widened
+				if isChangeable, err := bigQueryTableSchemaIsChangeable(valOld, valNew, false, nil, changes); err != nil || !isChangeable {
+					return false, err
+				}
 
-				// other parameters: description, policyTags and
-				// policyTags.names[] are changeable
+				// other parameters: description, defaultValueExpression,
+				// policyTags and policyTags.names[] are changeable
 			}
 		}
 		return true, nil
@@ -302,36 +465,68 @@ func resourceBigQueryTableSchemaIsChangeable(old, new interface{}) (bool, error)
 	}
 }
 
+func unmarshalBigQueryTableSchemaChange(d TerraformResourceDataChange) (interface{}, interface{}) {
+	oldSchema, newSchema := d.GetChange("schema")
+	oldSchemaText := oldSchema.(string)
+	newSchemaText := newSchema.(string)
+	if oldSchemaText == "null" {
+		// The API can return an empty schema which gets encoded to "null" during read.
+		oldSchemaText = "[]"
+	}
+	if newSchemaText == "null" {
+		newSchemaText = "[]"
+	}
+	var old, new interface{}
+	if err := json.Unmarshal([]byte(oldSchemaText), &old); err != nil {
+		// don't return error, its possible we are going from no schema to schema
+		// this case will be cover on the conparision regardless.
+		log.Printf("[DEBUG] unable to unmarshal json customized diff - %v", err)
+	}
+	if err := json.Unmarshal([]byte(newSchemaText), &new); err != nil {
+		// same as above
+		log.Printf("[DEBUG] unable to unmarshal json customized diff - %v", err)
+	}
+	return old, new
+}
+
+// bigQueryTableSchemaAllowsDDL reports whether columns of the table can be
+// changed with DDL statements, which only apply to native tables.
+func bigQueryTableSchemaAllowsDDL(d interface {
+	GetOk(string) (interface{}, bool)
+}) bool {
+	for _, k := range []string{"view", "materialized_view", "external_data_configuration"} {
+		if _, ok := d.GetOk(k); ok {
+			return false
+		}
+	}
+	return true
+}
+
 func resourceBigQueryTableSchemaCustomizeDiffFunc(d TerraformResourceDiff) error {
 	if _, hasSchema := d.GetOk("schema"); hasSchema {
-		oldSchema, newSchema := d.GetChange("schema")
-		oldSchemaText := oldSchema.(string)
-		newSchemaText := newSchema.(string)
-		if oldSchemaText == "null" {
-			// The API can return an empty schema which gets encoded to "null" during read.
-			oldSchemaText = "[]"
-		}
-		if newSchemaText == "null" {
-			newSchemaText = "[]"
-		}
-		var old, new interface{}
-		if err := json.Unmarshal([]byte(oldSchemaText), &old); err != nil {
-			// don't return error, its possible we are going from no schema to schema
-			// this case will be cover on the conparision regardless.
-			log.Printf("[DEBUG] unable to unmarshal json customized diff - %v", err)
-		}
-		if err := json.Unmarshal([]byte(newSchemaText), &new); err != nil {
-			// same as above
-			log.Printf("[DEBUG] unable to unmarshal json customized diff - %v", err)
-		}
-		isChangeable, err := resourceBigQueryTableSchemaIsChangeable(old, new)
+		old, new := unmarshalBigQueryTableSchemaChange(d)
+		changes, isChangeable, err := resourceBigQueryTableSchemaChanges(old, new, bigQueryTableSchemaAllowsDDL(d), bigQueryTableColumnRenames(d))
 		if err != nil {
 			return err
 		}
 		if !isChangeable {
+			log.Printf("[INFO] BigQuery table schema change can't be made in place, the table will be recreated")
 			if err := d.ForceNew("schema"); err != nil {
 				return err
 			}
+			return nil
+		}
+		if changes.isEmpty() {
+			return nil
+		}
+		// Dropping a column loses its data, like recreating the table would
+		if protected, ok := d.Get("deletion_protection").(bool); ok && protected && len(changes.droppedColumns) > 0 {
+			return fmt.Errorf("cannot drop column(s) %s from the table without setting deletion_protection=false", strings.Join(changes.droppedColumns, ", "))
+		}
+		// Surface the changes in the plan, as the schema diff alone doesn't
+		// tell columns being dropped or renamed apart from other updates.
+		if err := d.SetNew("schema_changes", changes.ddlActions()); err != nil {
+			return err
 		}
 		return nil
 	}
@@ -934,6 +1129,24 @@ func resourceBigQueryTable() *schema.Resource {
 				Default:     true,
 				Description: `Whether or not to allow Terraform to destroy the instance. Unless this field is set to false in Terraform state, a terraform destroy or terraform apply that would delete the instance will fail.`,
 			},
+
+			// ColumnRenames: Top-level columns to rename when they're renamed in
+			// schema, keyed by their old name.
+			"column_renames": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `A map of old to new names of top-level columns renamed in schema. A column that's gone from schema is only renamed in place when it's listed here, and is otherwise dropped. Entries for renames that have already been made are ignored.`,
+			},
+
+			// SchemaChanges: The DDL actions run by the last in-place update of
+			// the schema.
+			"schema_changes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The ALTER TABLE actions, such as dropping or renaming a column, run by the last in-place update of schema. They're shown in the plan before being run.`,
+			},
 		},
 		UseJSONNumber: true,
 	}
@@ -1261,6 +1474,21 @@ func resourceBigQueryTableUpdate(d *schema.ResourceData, meta interface{}) error
 	datasetID := d.Get("dataset_id").(string)
 	tableID := d.Get("table_id").(string)
 
+	if d.HasChange("schema") {
+		old, new := unmarshalBigQueryTableSchemaChange(d)
+		changes, isChangeable, err := resourceBigQueryTableSchemaChanges(old, new, bigQueryTableSchemaAllowsDDL(d), bigQueryTableColumnRenames(d))
+		if err != nil {
+			return err
+		}
+		// the schema would have been marked ForceNew otherwise
+		if isChangeable && !changes.isEmpty() {
+			statements := changes.ddlStatements(project, datasetID, tableID)
+			if err := runBigQueryTableDDL(config, userAgent, project, statements, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("Error updating schema of BigQuery table %q: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if _, err = config.NewBigQueryClient(userAgent).Tables.Update(project, datasetID, tableID, table).Do(); err != nil {
 		return err
 	}
@@ -1268,6 +1496,44 @@ func resourceBigQueryTableUpdate(d *schema.ResourceData, meta interface{}) error
 	return resourceBigQueryTableRead(d, meta)
 }
 
+// runBigQueryTableDDL runs statements as a single script and waits for it to
+// complete.
+func runBigQueryTableDDL(config *Config, userAgent, project string, statements []string, timeout time.Duration) error {
+	useLegacySql := false
+	job := &bigquery.Job{
+		Configuration: &bigquery.JobConfiguration{
+			Query: &bigquery.JobConfigurationQuery{
+				Query:        strings.Join(statements, ";\n"),
+				UseLegacySql: &useLegacySql,
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Running BigQuery DDL: %s", job.Configuration.Query.Query)
+	res, err := config.NewBigQueryClient(userAgent).Jobs.Insert(project, job).Do()
+	if err != nil {
+		return err
+	}
+
+	jobRef := res.JobReference
+	return resource.Retry(timeout, func() *resource.RetryError {
+		job, err := config.NewBigQueryClient(userAgent).Jobs.Get(jobRef.ProjectId, jobRef.JobId).Location(jobRef.Location).Do()
+		if err != nil {
+			if isRetryableError(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if job.Status == nil || job.Status.State != "DONE" {
+			return resource.RetryableError(fmt.Errorf("BigQuery job %q is still running", jobRef.JobId))
+		}
+		if job.Status.ErrorResult != nil {
+			return resource.NonRetryableError(fmt.Errorf("BigQuery job %q failed: %s", jobRef.JobId, job.Status.ErrorResult.Message))
+		}
+		return nil
+	})
+}
+
 func resourceBigQueryTableDelete(d *schema.ResourceData, meta interface{}) error {
 	if d.Get("deletion_protection").(bool) {
 		return fmt.Errorf("cannot destroy instance without setting deletion_protection=false and running `terraform apply`")
@@ -3,6 +3,7 @@ package google
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -424,6 +425,65 @@ func TestAccBigQueryTable_Basic(t *testing.T) {
 	})
 }
 
+func TestAccBigQueryTable_schemaEvolution(t *testing.T) {
+	t.Parallel()
+
+	datasetID := fmt.Sprintf("tf_test_%s", randString(t, 10))
+	tableID := fmt.Sprintf("tf_test_%s", randString(t, 10))
+
+	var creationTime string
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigQueryTableDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryTableSchemaEvolution(datasetID, tableID, `[
+    { "name": "id", "type": "INT64", "mode": "REQUIRED" },
+    { "name": "amount", "type": "INT64" },
+    { "name": "legacy", "type": "STRING" },
+    { "name": "comment", "type": "STRING", "description": "a comment" },
+    { "name": "details", "type": "RECORD", "fields": [
+      { "name": "note", "type": "STRING", "description": "a note" }
+    ] }
+  ]`),
+				Check: resource.TestCheckResourceAttrWith("google_bigquery_table.test", "creation_time", func(v string) error {
+					creationTime = v
+					return nil
+				}),
+			},
+			{
+				Config: testAccBigQueryTableSchemaEvolution(datasetID, tableID, `[
+    { "name": "id", "type": "INT64", "mode": "REQUIRED" },
+    { "name": "amount", "type": "NUMERIC" },
+    { "name": "remark", "type": "STRING" },
+    { "name": "details", "type": "RECORD", "fields": [
+      { "name": "note", "type": "STRING", "description": "an updated note", "defaultValueExpression": "'none'" }
+    ] }
+  ]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("google_bigquery_table.test", "creation_time", func(v string) error {
+						if v != creationTime {
+							return fmt.Errorf("expected the table to be updated in place, but it was recreated")
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("google_bigquery_table.test", "schema_changes.#", "3"),
+					resource.TestCheckResourceAttr("google_bigquery_table.test", "schema_changes.0", "DROP COLUMN `comment`"),
+					resource.TestCheckResourceAttr("google_bigquery_table.test", "schema_changes.1", "RENAME COLUMN `legacy` TO `remark`"),
+					resource.TestCheckResourceAttr("google_bigquery_table.test", "schema_changes.2", "ALTER COLUMN `amount` SET DATA TYPE NUMERIC"),
+				),
+			},
+			{
+				ResourceName:            "google_bigquery_table.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection", "schema_changes", "column_renames"},
+			},
+		},
+	})
+}
+
 func TestAccBigQueryTable_Kms(t *testing.T) {
 	t.Parallel()
 	resourceName := "google_bigquery_table.test"
@@ -1129,6 +1189,8 @@ type testUnitBigQueryDataTableJSONChangeableTestCase struct {
 	jsonOld    string
 	jsonNew    string
 	changeable bool
+	// the change is made with DDL, which only applies to top-level columns
+	onlyTopLevel bool
 }
 
 func (testcase *testUnitBigQueryDataTableJSONChangeableTestCase) check(t *testing.T) {
@@ -1178,10 +1240,11 @@ var testUnitBigQueryDataTableIsChangableTestCases = []testUnitBigQueryDataTableJ
 		changeable: true,
 	},
 	{
-		name:       "arraySizeDecreases",
-		jsonOld:    "[{\"name\": \"someValue\", \"type\" : \"INTEGER\", \"mode\" : \"NULLABLE\", \"description\" : \"someVal\" }, {\"name\": \"asomeValue\", \"type\" : \"INTEGER\", \"mode\" : \"NULLABLE\", \"description\" : \"someVal\" }]",
-		jsonNew:    "[{\"name\": \"someValue\", \"type\" : \"INTEGER\", \"mode\" : \"NULLABLE\", \"description\" : \"someVal\" }]",
-		changeable: false,
+		name:         "arraySizeDecreases",
+		jsonOld:      "[{\"name\": \"someValue\", \"type\" : \"INTEGER\", \"mode\" : \"NULLABLE\", \"description\" : \"someVal\" }, {\"name\": \"asomeValue\", \"type\" : \"INTEGER\", \"mode\" : \"NULLABLE\", \"description\" : \"someVal\" }]",
+		jsonNew:      "[{\"name\": \"someValue\", \"type\" : \"INTEGER\", \"mode\" : \"NULLABLE\", \"description\" : \"someVal\" }]",
+		changeable:   true,
+		onlyTopLevel: true,
 	},
 	{
 		name:       "descriptionChanges",
@@ -1254,11 +1317,59 @@ var testUnitBigQueryDataTableIsChangableTestCases = []testUnitBigQueryDataTableJ
 		changeable: true,
 	},
 	{
-		name:       "orderOfArrayChangesAndNameChanges",
-		jsonOld:    "[{\"name\": \"value1\", \"type\" : \"INTEGER\", \"mode\" : \"NULLABLE\", \"description\" : \"someVal\" }, {\"name\": \"value2\", \"type\" : \"BOOLEAN\", \"mode\" : \"NULLABLE\", \"description\" : \"someVal\" }]",
-		jsonNew:    "[{\"name\": \"value3\", \"type\" : \"BOOLEAN\", \"mode\" : \"NULLABLE\", \"description\" : \"newVal\" },  {\"name\": \"value1\", \"type\" : \"INTEGER\", \"mode\" : \"NULLABLE\", \"description\" : \"someVal\" }]",
+		name:         "orderOfArrayChangesAndNameChanges",
+		jsonOld:      "[{\"name\": \"value1\", \"type\" : \"INTEGER\", \"mode\" : \"NULLABLE\", \"description\" : \"someVal\" }, {\"name\": \"value2\", \"type\" : \"BOOLEAN\", \"mode\" : \"NULLABLE\", \"description\" : \"someVal\" }]",
+		jsonNew:      "[{\"name\": \"value3\", \"type\" : \"BOOLEAN\", \"mode\" : \"NULLABLE\", \"description\" : \"newVal\" },  {\"name\": \"value1\", \"type\" : \"INTEGER\", \"mode\" : \"NULLABLE\", \"description\" : \"someVal\" }]",
+		changeable:   true,
+		onlyTopLevel: true,
+	},
+	{
+		// without a requested rename, the old column is dropped and a REQUIRED
+		// column can't be added in place
+		name:       "columnReplacedByRequiredColumn",
+		jsonOld:    "[{\"name\": \"value1\", \"type\" : \"INTEGER\", \"mode\" : \"NULLABLE\", \"description\" : \"someVal\" }, {\"name\": \"value2\", \"type\" : \"BOOLEAN\", \"mode\" : \"REQUIRED\" }]",
+		jsonNew:    "[{\"name\": \"value1\", \"type\" : \"INTEGER\", \"mode\" : \"NULLABLE\", \"description\" : \"someVal\" }, {\"name\": \"value3\", \"type\" : \"BOOLEAN\", \"mode\" : \"REQUIRED\" }]",
 		changeable: false,
 	},
+	{
+		name:         "columnReplaced",
+		jsonOld:      "[{\"name\": \"value1\", \"type\" : \"INTEGER\", \"mode\" : \"NULLABLE\", \"description\" : \"someVal\" }, {\"name\": \"value2\", \"type\" : \"BOOLEAN\", \"mode\" : \"NULLABLE\" }]",
+		jsonNew:      "[{\"name\": \"value1\", \"type\" : \"INTEGER\", \"mode\" : \"NULLABLE\", \"description\" : \"someVal\" }, {\"name\": \"value3\", \"type\" : \"BOOLEAN\", \"mode\" : \"NULLABLE\" }]",
+		changeable:   true,
+		onlyTopLevel: true,
+	},
+	{
+		name:         "typeWidenedToNumeric",
+		jsonOld:      "[{\"name\": \"someValue\", \"type\" : \"INTEGER\", \"mode\" : \"NULLABLE\" }]",
+		jsonNew:      "[{\"name\": \"someValue\", \"type\" : \"NUMERIC\", \"mode\" : \"NULLABLE\" }]",
+		changeable:   true,
+		onlyTopLevel: true,
+	},
+	{
+		name:         "typeWidenedToBigNumeric",
+		jsonOld:      "[{\"name\": \"someValue\", \"type\" : \"NUMERIC\", \"mode\" : \"NULLABLE\" }]",
+		jsonNew:      "[{\"name\": \"someValue\", \"type\" : \"BIGNUMERIC\", \"mode\" : \"NULLABLE\" }]",
+		changeable:   true,
+		onlyTopLevel: true,
+	},
+	{
+		name:       "typeNarrowed",
+		jsonOld:    "[{\"name\": \"someValue\", \"type\" : \"NUMERIC\", \"mode\" : \"NULLABLE\" }]",
+		jsonNew:    "[{\"name\": \"someValue\", \"type\" : \"INT64\", \"mode\" : \"NULLABLE\" }]",
+		changeable: false,
+	},
+	{
+		name:       "repeatedTypeWidened",
+		jsonOld:    "[{\"name\": \"someValue\", \"type\" : \"INT64\", \"mode\" : \"REPEATED\" }]",
+		jsonNew:    "[{\"name\": \"someValue\", \"type\" : \"NUMERIC\", \"mode\" : \"REPEATED\" }]",
+		changeable: false,
+	},
+	{
+		name:       "defaultValueChanges",
+		jsonOld:    "[{\"name\": \"someValue\", \"type\" : \"STRING\", \"defaultValueExpression\" : \"'a'\" }]",
+		jsonNew:    "[{\"name\": \"someValue\", \"type\" : \"STRING\", \"defaultValueExpression\" : \"'b'\" }]",
+		changeable: true,
+	},
 	{
 		name: "policyTags",
 		jsonOld: `[
@@ -1289,15 +1400,168 @@ func TestUnitBigQueryDataTable_schemaIsChangable(t *testing.T) {
 	for _, testcase := range testUnitBigQueryDataTableIsChangableTestCases {
 		testcase.check(t)
 		testcaseNested := &testUnitBigQueryDataTableJSONChangeableTestCase{
-			testcase.name + "Nested",
-			fmt.Sprintf("[{\"name\": \"someValue\", \"type\" : \"INTEGER\", \"fields\" : %s }]", testcase.jsonOld),
-			fmt.Sprintf("[{\"name\": \"someValue\", \"type\" : \"INT64\", \"fields\" : %s }]", testcase.jsonNew),
-			testcase.changeable,
+			name:       testcase.name + "Nested",
+			jsonOld:    fmt.Sprintf("[{\"name\": \"someValue\", \"type\" : \"INTEGER\", \"fields\" : %s }]", testcase.jsonOld),
+			jsonNew:    fmt.Sprintf("[{\"name\": \"someValue\", \"type\" : \"INT64\", \"fields\" : %s }]", testcase.jsonNew),
+			changeable: testcase.changeable && !testcase.onlyTopLevel,
 		}
 		testcaseNested.check(t)
 	}
 }
 
+func TestUnitBigQueryDataTable_schemaChangesDDL(t *testing.T) {
+	t.Parallel()
+
+	var old, new interface{}
+	if err := json.Unmarshal([]byte(`[
+		{"name": "id", "type": "INTEGER", "mode": "REQUIRED"},
+		{"name": "amount", "type": "INT64"},
+		{"name": "legacy", "type": "STRING"},
+		{"name": "note", "type": "STRING", "description": "a note"}
+	]`), &old); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(`[
+		{"name": "id", "type": "INTEGER", "mode": "REQUIRED"},
+		{"name": "amount", "type": "NUMERIC"},
+		{"name": "comment", "type": "STRING"},
+		{"name": "note", "type": "STRING", "description": "a note"},
+		{"name": "added", "type": "STRING", "description": "a new column"}
+	]`), &new); err != nil {
+		t.Fatal(err)
+	}
+
+	changes, changeable, err := resourceBigQueryTableSchemaChanges(old, new, true, map[string]string{"legacy": "comment"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changeable {
+		t.Fatalf("expected schema to be changeable in place")
+	}
+	expected := []string{
+		"ALTER TABLE `my-project.my_dataset.my_table` RENAME COLUMN `legacy` TO `comment`",
+		"ALTER TABLE `my-project.my_dataset.my_table` ALTER COLUMN `amount` SET DATA TYPE NUMERIC",
+	}
+	if got := changes.ddlStatements("my-project", "my_dataset", "my_table"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected statements %v, got %v", expected, got)
+	}
+
+	// Without a requested rename, replacing the column with one of the same
+	// definition drops it and adds the new one
+	changes, changeable, err = resourceBigQueryTableSchemaChanges(old, new, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changeable {
+		t.Fatalf("expected schema to be changeable in place")
+	}
+	expected = []string{
+		"DROP COLUMN `legacy`",
+		"ALTER COLUMN `amount` SET DATA TYPE NUMERIC",
+	}
+	if got := changes.ddlActions(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected actions %v, got %v", expected, got)
+	}
+
+	// Removing the column instead of replacing it drops it, even when a rename
+	// is requested
+	if err := json.Unmarshal([]byte(`[
+		{"name": "id", "type": "INTEGER", "mode": "REQUIRED"},
+		{"name": "amount", "type": "INT64"},
+		{"name": "note", "type": "STRING", "description": "a note"}
+	]`), &new); err != nil {
+		t.Fatal(err)
+	}
+	changes, changeable, err = resourceBigQueryTableSchemaChanges(old, new, true, map[string]string{"legacy": "comment"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changeable {
+		t.Fatalf("expected schema to be changeable in place")
+	}
+	expected = []string{"ALTER TABLE `my-project.my_dataset.my_table` DROP COLUMN `legacy`"}
+	if got := changes.ddlStatements("my-project", "my_dataset", "my_table"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected statements %v, got %v", expected, got)
+	}
+
+	// A renamed column can't change its definition in a way that forces a new
+	// table
+	if err := json.Unmarshal([]byte(`[
+		{"name": "id", "type": "INTEGER", "mode": "REQUIRED"},
+		{"name": "amount", "type": "INT64"},
+		{"name": "note", "type": "STRING", "description": "a note"},
+		{"name": "comment", "type": "BOOLEAN"}
+	]`), &new); err != nil {
+		t.Fatal(err)
+	}
+	if _, changeable, _ = resourceBigQueryTableSchemaChanges(old, new, true, map[string]string{"legacy": "comment"}); changeable {
+		t.Errorf("expected renaming a column while changing its type to force a new table")
+	}
+
+	// DDL doesn't apply to views and external tables
+	if _, changeable, _ = resourceBigQueryTableSchemaChanges(old, new, false, nil); changeable {
+		t.Errorf("expected dropping a column without DDL to force a new table")
+	}
+
+	d := &ResourceDiffMock{
+		Before: map[string]interface{}{"schema": `[{"name": "a", "type": "STRING"}, {"name": "b", "type": "STRING"}]`},
+		After: map[string]interface{}{
+			"schema":                      `[{"name": "a", "type": "STRING"}]`,
+			"external_data_configuration": []interface{}{map[string]interface{}{}},
+		},
+	}
+	if err := resourceBigQueryTableSchemaCustomizeDiffFunc(d); err != nil {
+		t.Fatal(err)
+	}
+	if !d.IsForceNew {
+		t.Errorf("expected dropping a column from an external table to force a new table")
+	}
+
+	// Dropping a column is shown in the plan, and respects deletion_protection
+	d = &ResourceDiffMock{
+		Before: map[string]interface{}{"schema": `[{"name": "a", "type": "STRING"}, {"name": "b", "type": "STRING"}]`},
+		After: map[string]interface{}{
+			"schema":              `[{"name": "a", "type": "STRING"}]`,
+			"deletion_protection": true,
+		},
+	}
+	if err := resourceBigQueryTableSchemaCustomizeDiffFunc(d); err == nil {
+		t.Errorf("expected dropping a column from a table with deletion_protection to fail")
+	}
+	d.After["deletion_protection"] = false
+	if err := resourceBigQueryTableSchemaCustomizeDiffFunc(d); err != nil {
+		t.Fatal(err)
+	}
+	if d.IsForceNew {
+		t.Errorf("expected dropping a column to be made in place")
+	}
+	expected = []string{"DROP COLUMN `b`"}
+	if got := d.After["schema_changes"]; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected schema_changes %v, got %v", expected, got)
+	}
+
+	// Replacing a column with an unrelated one of the same type is a drop,
+	// which respects deletion_protection, unless the rename is requested
+	d = &ResourceDiffMock{
+		Before: map[string]interface{}{"schema": `[{"name": "id", "type": "INT64"}, {"name": "email", "type": "STRING", "mode": "NULLABLE"}]`},
+		After: map[string]interface{}{
+			"schema":              `[{"name": "id", "type": "INT64"}, {"name": "phone", "type": "STRING", "mode": "NULLABLE"}]`,
+			"deletion_protection": true,
+		},
+	}
+	if err := resourceBigQueryTableSchemaCustomizeDiffFunc(d); err == nil {
+		t.Errorf("expected replacing a column in a table with deletion_protection to fail")
+	}
+	d.After["column_renames"] = map[string]interface{}{"email": "phone"}
+	if err := resourceBigQueryTableSchemaCustomizeDiffFunc(d); err != nil {
+		t.Fatal(err)
+	}
+	expected = []string{"RENAME COLUMN `email` TO `phone`"}
+	if got := d.After["schema_changes"]; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected schema_changes %v, got %v", expected, got)
+	}
+}
+
 func testAccCheckBigQueryExtData(t *testing.T, expectedQuoteChar string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -1614,6 +1878,28 @@ resource "google_bigquery_table" "test" {
 `, datasetID, tableID)
 }
 
+func testAccBigQueryTableSchemaEvolution(datasetID, tableID, schema string) string {
+	return fmt.Sprintf(`
+resource "google_bigquery_dataset" "test" {
+  dataset_id = "%s"
+}
+
+resource "google_bigquery_table" "test" {
+  deletion_protection = false
+  table_id            = "%s"
+  dataset_id          = google_bigquery_dataset.test.dataset_id
+
+  column_renames = {
+    legacy = "remark"
+  }
+
+  schema = <<EOH
+  %s
+EOH
+}
+`, datasetID, tableID, schema)
+}
+
 func testAccBigQueryTableWithViewAndSchema(datasetID, tableID, desc string) string {
 	return fmt.Sprintf(`
 resource "google_bigquery_dataset" "test" {
@@ -0,0 +1,148 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudRunV2Job_cloudrunv2JobFullUpdate(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudRunV2JobDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRunV2Job_cloudrunv2JobFull(context),
+			},
+			{
+				ResourceName:            "google_cloud_run_v2_job.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name", "location"},
+			},
+			{
+				Config: testAccCloudRunV2Job_cloudrunv2JobFullUpdate(context),
+			},
+			{
+				ResourceName:            "google_cloud_run_v2_job.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name", "location"},
+			},
+		},
+	})
+}
+
+func testAccCloudRunV2Job_cloudrunv2JobFull(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloud_run_v2_job" "default" {
+  name     = "tf-test-cloudrun-job%{random_suffix}"
+  location = "us-central1"
+  launch_stage = "BETA"
+  labels = {
+    label-1 = "value-1"
+  }
+  client = "client-1"
+  client_version = "client-version-1"
+
+  template {
+    labels = {
+      label-1 = "value-1"
+    }
+    parallelism = 4
+    task_count = 4
+    template {
+      timeout = "300s"
+      service_account = google_service_account.service_account.email
+      execution_environment = "EXECUTION_ENVIRONMENT_GEN2"
+      containers {
+        name = "container-1"
+        image = "us-docker.pkg.dev/cloudrun/container/hello"
+        args = ["https://cloud.google.com/run", "www.google.com"]
+        command = ["/bin/echo"]
+        env {
+          name = "SOURCE"
+          value = "remote"
+        }
+        resources {
+          limits = {
+            cpu = "4"
+            memory = "2Gi"
+          }
+        }
+      }
+      max_retries = 5
+    }
+  }
+}
+
+resource "google_service_account" "service_account" {
+  account_id   = "tf-test-my-account%{random_suffix}"
+  display_name = "Test Service Account"
+}
+`, context)
+}
+
+func testAccCloudRunV2Job_cloudrunv2JobFullUpdate(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloud_run_v2_job" "default" {
+  name     = "tf-test-cloudrun-job%{random_suffix}"
+  location = "us-central1"
+  launch_stage = "BETA"
+  labels = {
+    label-1 = "value-update"
+  }
+  client = "client-update"
+  client_version = "client-version-update"
+
+  template {
+    labels = {
+      label-1 = "value-update"
+    }
+    parallelism = 2
+    task_count = 8
+    template {
+      timeout = "500s"
+      service_account = google_service_account.service_account.email
+      execution_environment = "EXECUTION_ENVIRONMENT_GEN1"
+      containers {
+        name = "container-update"
+        image = "us-docker.pkg.dev/cloudrun/container/hello"
+        args = ["https://cloud.google.com/run"]
+        command = ["printenv"]
+        env {
+          name = "SOURCE_UPDATE"
+          value = "remote-update"
+        }
+        resources {
+          limits = {
+            cpu = "2"
+            memory = "8Gi"
+          }
+        }
+      }
+      vpc_access {
+        egress = "PRIVATE_RANGES_ONLY"
+        network_interfaces {
+          network = "default"
+          subnetwork = "default"
+        }
+      }
+      max_retries = 0
+    }
+  }
+}
+
+resource "google_service_account" "service_account" {
+  account_id   = "tf-test-my-account%{random_suffix}"
+  display_name = "Test Service Account"
+}
+`, context)
+}
@@ -0,0 +1,195 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudRunV2Service_cloudrunv2ServiceFullUpdate(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudRunV2ServiceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRunV2Service_cloudrunv2ServiceFull(context),
+			},
+			{
+				ResourceName:            "google_cloud_run_v2_service.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name", "location"},
+			},
+			{
+				Config: testAccCloudRunV2Service_cloudrunv2ServiceFullUpdate(context),
+			},
+			{
+				ResourceName:            "google_cloud_run_v2_service.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name", "location"},
+			},
+		},
+	})
+}
+
+func testAccCloudRunV2Service_cloudrunv2ServiceFull(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloud_run_v2_service" "default" {
+  name     = "tf-test-cloudrun-service%{random_suffix}"
+  location = "us-central1"
+  description = "description creating"
+  client = "client-1"
+  client_version = "client-version-1"
+  ingress = "INGRESS_TRAFFIC_ALL"
+
+  labels = {
+    label-1 = "value-1"
+  }
+
+  template {
+    labels = {
+      label-1 = "value-1"
+    }
+    timeout = "300s"
+    service_account = google_service_account.service_account.email
+    execution_environment = "EXECUTION_ENVIRONMENT_GEN2"
+    scaling {
+      max_instance_count = 3
+      min_instance_count = 1
+    }
+    containers {
+      name = "container-1"
+      image = "us-docker.pkg.dev/cloudrun/container/hello"
+      env {
+        name = "SOURCE"
+        value = "remote"
+      }
+      env {
+        name = "TARGET"
+        value = "home"
+      }
+      ports {
+        name = "h2c"
+        container_port = 8080
+      }
+      resources {
+        cpu_idle = true
+        limits = {
+          cpu = "4"
+          memory = "2Gi"
+        }
+      }
+    }
+    session_affinity = false
+  }
+}
+
+resource "google_service_account" "service_account" {
+  account_id   = "tf-test-my-account%{random_suffix}"
+  display_name = "Test Service Account"
+}
+`, context)
+}
+
+func testAccCloudRunV2Service_cloudrunv2ServiceFullUpdate(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloud_run_v2_service" "default" {
+  name     = "tf-test-cloudrun-service%{random_suffix}"
+  location = "us-central1"
+  description = "description updating"
+  client = "client-update"
+  client_version = "client-version-update"
+  ingress = "INGRESS_TRAFFIC_INTERNAL_LOAD_BALANCER"
+  launch_stage = "BETA"
+
+  labels = {
+    label-1 = "value-update"
+  }
+
+  template {
+    revision = "tf-test-cloudrun-service%{random_suffix}-rev2"
+    labels = {
+      label-1 = "value-update"
+    }
+    timeout = "500s"
+    service_account = google_service_account.service_account.email
+    execution_environment = "EXECUTION_ENVIRONMENT_GEN1"
+    scaling {
+      max_instance_count = 2
+      min_instance_count = 1
+    }
+    max_instance_request_concurrency = 10
+    containers {
+      name = "container-update"
+      image = "us-docker.pkg.dev/cloudrun/container/hello"
+      args = ["arg1", "arg2"]
+      command = ["/bin/sh", "-c"]
+      env {
+        name = "SOURCE_UPDATE"
+        value = "remote-update"
+      }
+      ports {
+        name = "http1"
+        container_port = 8080
+      }
+      resources {
+        cpu_idle = false
+        startup_cpu_boost = true
+        limits = {
+          cpu = "2"
+          memory = "8Gi"
+        }
+      }
+      depends_on = ["sidecar"]
+      liveness_probe {
+        initial_delay_seconds = 10
+        period_seconds = 20
+        http_get {
+          path = "/healthz"
+        }
+      }
+    }
+    containers {
+      name = "sidecar"
+      image = "us-docker.pkg.dev/cloudrun/container/hello"
+      env {
+        name = "PORT"
+        value = "8081"
+      }
+      startup_probe {
+        tcp_socket {
+          port = 8081
+        }
+      }
+    }
+    vpc_access {
+      egress = "ALL_TRAFFIC"
+      network_interfaces {
+        network = "default"
+        subnetwork = "default"
+      }
+    }
+    session_affinity = true
+  }
+
+  traffic {
+    type = "TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST"
+    percent = 100
+    tag = "latest"
+  }
+}
+
+resource "google_service_account" "service_account" {
+  account_id   = "tf-test-my-account%{random_suffix}"
+  display_name = "Test Service Account"
+}
+`, context)
+}
@@ -35,6 +35,8 @@ func resourceCloudfunctions2function() *schema.Resource {
 			State: resourceCloudfunctions2functionImport,
 		},
 
+		CustomizeDiff: cloudFunctionsSourceDirCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -363,6 +365,19 @@ timeout period. Defaults to 60 seconds.`,
 				Computed:    true,
 				Description: `The last update timestamp of a Cloud Function.`,
 			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `A local directory containing the source of the function. It is zipped, respecting any
+.gcloudignore file in the directory, and uploaded whenever its contents change. Cannot be set
+alongside build_config.source.`,
+				ConflictsWith: []string{"build_config.0.source"},
+			},
+			"source_code_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `A hash of the files deployed from source_dir.`,
+			},
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -448,6 +463,11 @@ func resourceCloudfunctions2functionCreate(d *schema.ResourceData, meta interfac
 		obj["labels"] = labelsProp
 	}
 
+	obj, err = resourceCloudfunctions2functionEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
 	url, err := replaceVars(d, config, "{{Cloudfunctions2BasePath}}projects/{{project}}/locations/{{location}}/functions?functionId={{name}}")
 	if err != nil {
 		return err
@@ -619,6 +639,11 @@ func resourceCloudfunctions2functionUpdate(d *schema.ResourceData, meta interfac
 		obj["labels"] = labelsProp
 	}
 
+	obj, err = resourceCloudfunctions2functionUpdateEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
 	url, err := replaceVars(d, config, "{{Cloudfunctions2BasePath}}projects/{{project}}/locations/{{location}}/functions/{{name}}")
 	if err != nil {
 		return err
@@ -631,7 +656,7 @@ func resourceCloudfunctions2functionUpdate(d *schema.ResourceData, meta interfac
 		updateMask = append(updateMask, "description")
 	}
 
-	if d.HasChange("build_config") {
+	if d.HasChange("build_config") || d.HasChange("source_dir") || d.HasChange("source_code_hash") {
 		updateMask = append(updateMask, "buildConfig")
 	}
 
@@ -802,6 +827,10 @@ func flattenCloudfunctions2functionBuildConfigSource(v interface{}, d *schema.Re
 	if v == nil {
 		return nil
 	}
+	// Source uploaded from source_dir is tracked through source_code_hash
+	if _, ok := d.GetOk("source_dir"); ok {
+		return nil
+	}
 	original := v.(map[string]interface{})
 	if len(original) == 0 {
 		return nil
@@ -96,6 +96,8 @@ func resourceCloudFunctionsFunction() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: cloudFunctionsSourceDirCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
@@ -113,15 +115,30 @@ func resourceCloudFunctionsFunction() *schema.Resource {
 			},
 
 			"source_archive_bucket": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: `The GCS bucket containing the zip archive which contains the function.`,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   `The GCS bucket containing the zip archive which contains the function.`,
+				ConflictsWith: []string{"source_dir"},
 			},
 
 			"source_archive_object": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   `The source archive object (file) in archive bucket.`,
+				ConflictsWith: []string{"source_dir"},
+			},
+
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   `A local directory containing the source of the function. It is zipped, respecting any .gcloudignore file in the directory, and uploaded whenever its contents change. Cannot be set alongside source_archive_bucket, source_archive_object or source_repository.`,
+				ConflictsWith: []string{"source_archive_bucket", "source_archive_object", "source_repository"},
+			},
+
+			"source_code_hash": {
 				Type:        schema.TypeString,
-				Optional:    true,
-				Description: `The source archive object (file) in archive bucket.`,
+				Computed:    true,
+				Description: `A hash of the files deployed from source_dir.`,
 			},
 
 			"source_repository": {
@@ -129,7 +146,7 @@ func resourceCloudFunctionsFunction() *schema.Resource {
 				Optional:      true,
 				MaxItems:      1,
 				Description:   `Represents parameters related to source repository where a function is hosted. Cannot be set alongside source_archive_bucket or source_archive_object.`,
-				ConflictsWith: []string{"source_archive_bucket", "source_archive_object"},
+				ConflictsWith: []string{"source_archive_bucket", "source_archive_object", "source_dir"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"url": {
@@ -455,11 +472,17 @@ func resourceCloudFunctionsCreate(d *schema.ResourceData, meta interface{}) erro
 	sourceRepos := d.Get("source_repository").([]interface{})
 	if len(sourceRepos) > 0 {
 		function.SourceRepository = expandSourceRepository(sourceRepos)
+	} else if _, ok := d.GetOk("source_dir"); ok {
+		uploadUrl, err := uploadCloudFunctionsSourceDir(d, config, cloudFuncId, userAgent, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+		function.SourceUploadUrl = uploadUrl
 	} else {
 		sourceArchiveBucket := d.Get("source_archive_bucket").(string)
 		sourceArchiveObj := d.Get("source_archive_object").(string)
 		if sourceArchiveBucket == "" || sourceArchiveObj == "" {
-			return fmt.Errorf("either source_repository or both of source_archive_bucket+source_archive_object must be set")
+			return fmt.Errorf("either source_repository, source_dir or both of source_archive_bucket+source_archive_object must be set")
 		}
 		function.SourceArchiveUrl = fmt.Sprintf("gs://%v/%v", sourceArchiveBucket, sourceArchiveObj)
 	}
@@ -745,6 +768,18 @@ func resourceCloudFunctionsUpdate(d *schema.ResourceData, meta interface{}) erro
 		updateMaskArr = append(updateMaskArr, "sourceRepository")
 	}
 
+	if _, ok := d.GetOk("source_dir"); ok && (d.HasChange("source_dir") || d.HasChange("source_code_hash")) {
+		uploadUrl, err := uploadCloudFunctionsSourceDir(d, config, cloudFuncId, userAgent, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+		// The function's source is a oneof, so the previous source has to be cleared
+		function.SourceArchiveUrl = ""
+		function.SourceRepository = nil
+		function.SourceUploadUrl = uploadUrl
+		updateMaskArr = append(updateMaskArr, "sourceUploadUrl")
+	}
+
 	if d.HasChange("secret_environment_variables") {
 		function.SecretEnvironmentVariables = expandSecretEnvironmentVariables(d.Get("secret_environment_variables").([]interface{}))
 		updateMaskArr = append(updateMaskArr, "secretEnvironmentVariables")
@@ -857,6 +892,33 @@ func resourceCloudFunctionsUpdate(d *schema.ResourceData, meta interface{}) erro
 	return resourceCloudFunctionsRead(d, meta)
 }
 
+// uploadCloudFunctionsSourceDir zips source_dir and uploads it to a URL
+// generated for the function, returning the URL to deploy the function from.
+func uploadCloudFunctionsSourceDir(d *schema.ResourceData, config *Config, cloudFuncId *cloudFunctionId, userAgent string, timeout time.Duration) (string, error) {
+	archive, hash, err := zipCloudFunctionSourceDir(d.Get("source_dir").(string))
+	if err != nil {
+		return "", err
+	}
+
+	res, err := config.NewCloudFunctionsClient(userAgent).Projects.Locations.Functions.GenerateUploadUrl(
+		cloudFuncId.locationId(), &cloudfunctions.GenerateUploadUrlRequest{}).Do()
+	if err != nil {
+		return "", fmt.Errorf("Error generating upload URL for CloudFunctions Function %q: %s", cloudFuncId.Name, err)
+	}
+
+	log.Printf("[DEBUG] Uploading %d byte source archive for CloudFunctions Function %q", len(archive), cloudFuncId.Name)
+	// The upload URL only accepts archives of up to 100MB.
+	headers := map[string]string{"x-goog-content-length-range": "0,104857600"}
+	if err := uploadCloudFunctionSourceArchive(config.context, res.UploadUrl, archive, headers, timeout); err != nil {
+		return "", err
+	}
+
+	if err := d.Set("source_code_hash", hash); err != nil {
+		return "", fmt.Errorf("Error setting source_code_hash: %s", err)
+	}
+	return res.UploadUrl, nil
+}
+
 func resourceCloudFunctionsDestroy(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	userAgent, err := generateUserAgentString(d, config.userAgent)
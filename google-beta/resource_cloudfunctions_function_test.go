@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -564,6 +565,58 @@ func TestAccCloudFunctionsFunction_secretMount(t *testing.T) {
 	})
 }
 
+func TestAccCloudFunctionsFunction_sourceDir(t *testing.T) {
+	t.Parallel()
+
+	funcResourceName := "google_cloudfunctions_function.function"
+	functionName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	sourceDir := createSourceDirForCloudFunction(t, testHTTPTriggerPath)
+	defer os.RemoveAll(sourceDir) // clean up
+
+	var hash string
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFunctionsFunctionDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudFunctionsFunction_sourceDir(functionName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(funcResourceName, "source_code_hash"),
+					resource.TestCheckResourceAttrWith(funcResourceName, "source_code_hash", func(v string) error {
+						hash = v
+						return nil
+					}),
+				),
+			},
+			{
+				PreConfig: func() {
+					b, err := ioutil.ReadFile(testHTTPTriggerUpdatePath)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if err := ioutil.WriteFile(filepath.Join(sourceDir, "index.js"), b, 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccCloudFunctionsFunction_sourceDir(functionName, sourceDir),
+				Check: resource.TestCheckResourceAttrWith(funcResourceName, "source_code_hash", func(v string) error {
+					if v == hash {
+						return fmt.Errorf("expected source_code_hash to change after editing source_dir")
+					}
+					return nil
+				}),
+			},
+			{
+				ResourceName:            funcResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_dir", "source_code_hash"},
+			},
+		},
+	})
+}
+
 func testAccCheckCloudFunctionsFunctionDestroyProducer(t *testing.T) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
 		config := googleProviderConfig(t)
@@ -715,6 +768,21 @@ func createZIPArchiveForCloudFunctionSource(t *testing.T, sourcePath string) str
 	return tmpfile.Name()
 }
 
+func createSourceDirForCloudFunction(t *testing.T, sourcePath string) string {
+	dir, err := ioutil.TempDir("", "cloudfuncsrc")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	source, err := ioutil.ReadFile(sourcePath)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "index.js"), source, 0644); err != nil {
+		t.Fatal(err.Error())
+	}
+	return dir
+}
+
 func sweepCloudFunctionSourceZipArchives(_ string) error {
 	files, err := ioutil.ReadDir(os.TempDir())
 	if err != nil {
@@ -777,6 +845,19 @@ resource "google_cloudfunctions_function" "function" {
 `, bucketName, zipFilePath, functionName)
 }
 
+func testAccCloudFunctionsFunction_sourceDir(functionName, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "google_cloudfunctions_function" "function" {
+  name                = "%s"
+  runtime             = "nodejs10"
+  available_memory_mb = 128
+  source_dir          = "%s"
+  trigger_http        = true
+  entry_point         = "helloGET"
+}
+`, functionName, sourceDir)
+}
+
 func testAccCloudFunctionsFunction_updated(functionName string, bucketName string, zipFilePath string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
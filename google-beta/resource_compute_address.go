@@ -40,6 +40,8 @@ func resourceComputeAddress() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: computeAddressQuotaPreflight,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -177,6 +179,8 @@ internally during updates.`,
 				Computed: true,
 				ForceNew: true,
 			},
+			"preflight_quota_warning": computeQuotaPreflightWarningSchema(),
+
 			"self_link": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -382,6 +386,10 @@ func resourceComputeAddressRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading Address: %s", err)
 	}
+	// The warning only belongs to the plan that found it.
+	if err := d.Set("preflight_quota_warning", ""); err != nil {
+		return fmt.Errorf("Error reading Address: %s", err)
+	}
 
 	if err := d.Set("address", flattenComputeAddressAddress(res["address"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Address: %s", err)
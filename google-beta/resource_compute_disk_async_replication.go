@@ -0,0 +1,255 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	compute "google.golang.org/api/compute/v0.beta"
+)
+
+func resourceComputeDiskAsyncReplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDiskAsyncReplicationCreate,
+		Read:   resourceDiskAsyncReplicationRead,
+		Delete: resourceDiskAsyncReplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDiskAsyncReplicationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"primary_disk": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      `Primary disk for asynchronous replication. Either a zonal disk in the format projects/{project}/zones/{zone}/disks/{disk} or a regional disk in the format projects/{project}/regions/{region}/disks/{disk}.`,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"secondary_disk": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: `Secondary disk for asynchronous replication.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disk": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							Description:      `Secondary disk for asynchronous replication. The secondary disk has to be created with async_primary_disk pointing at the primary disk.`,
+							DiffSuppressFunc: compareSelfLinkOrResourceName,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Output-only. Status of replication on the secondary disk.`,
+						},
+					},
+				},
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+// diskAsyncReplicationPrimary parses primary_disk, which may be either a zonal
+// or a regional disk. The returned location is a zone or a region respectively.
+func diskAsyncReplicationPrimary(d TerraformResourceData, config *Config) (regional bool, project, location, name string, err error) {
+	disk := d.Get("primary_disk").(string)
+	if strings.Contains(disk, "regions/") {
+		rv, err := ParseRegionDiskFieldValue(disk, d, config)
+		if err != nil {
+			return false, "", "", "", err
+		}
+		return true, rv.Project, rv.Region, rv.Name, nil
+	}
+
+	zv, err := ParseDiskFieldValue(disk, d, config)
+	if err != nil {
+		return false, "", "", "", err
+	}
+	return false, zv.Project, zv.Zone, zv.Name, nil
+}
+
+func diskAsyncReplicationGetPrimary(d TerraformResourceData, config *Config, userAgent string) (*compute.Disk, error) {
+	regional, project, location, name, err := diskAsyncReplicationPrimary(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if regional {
+		return config.NewComputeClient(userAgent).RegionDisks.Get(project, location, name).Do()
+	}
+	return config.NewComputeClient(userAgent).Disks.Get(project, location, name).Do()
+}
+
+// findDiskAsyncReplicationStatus looks up the replication status for secondary
+// in the resource status of the primary disk, which is keyed by disk url.
+func findDiskAsyncReplicationStatus(disk *compute.Disk, secondary string) (string, *compute.DiskResourceStatusAsyncReplicationStatus) {
+	if disk.ResourceStatus == nil {
+		return "", nil
+	}
+
+	for key, status := range disk.ResourceStatus.AsyncSecondaryDisks {
+		status := status
+		if secondary == "" || compareSelfLinkOrResourceName("", key, secondary, nil) {
+			return key, &status
+		}
+	}
+	return "", nil
+}
+
+func resourceDiskAsyncReplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	regional, project, location, name, err := diskAsyncReplicationPrimary(d, config)
+	if err != nil {
+		return err
+	}
+	secondaryDisk := d.Get("secondary_disk.0.disk").(string)
+
+	var op *compute.Operation
+	var id string
+	if regional {
+		req := &compute.RegionDisksStartAsyncReplicationRequest{
+			AsyncSecondaryDisk: secondaryDisk,
+		}
+		op, err = config.NewComputeClient(userAgent).RegionDisks.StartAsyncReplication(project, location, name, req).Do()
+		id = fmt.Sprintf("projects/%s/regions/%s/disks/%s", project, location, name)
+	} else {
+		req := &compute.DisksStartAsyncReplicationRequest{
+			AsyncSecondaryDisk: secondaryDisk,
+		}
+		op, err = config.NewComputeClient(userAgent).Disks.StartAsyncReplication(project, location, name, req).Do()
+		id = fmt.Sprintf("projects/%s/zones/%s/disks/%s", project, location, name)
+	}
+	if err != nil {
+		return fmt.Errorf("Error starting async replication for disk %q: %s", name, err)
+	}
+
+	d.SetId(id)
+
+	waitErr := computeOperationWaitTime(config, op, project, "starting async replication", userAgent, d.Timeout(schema.TimeoutCreate))
+	if waitErr != nil {
+		d.SetId("")
+		return waitErr
+	}
+
+	// Replication is reported as starting for a while after the operation is done.
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		disk, err := diskAsyncReplicationGetPrimary(d, config, userAgent)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, status := findDiskAsyncReplicationStatus(disk, secondaryDisk)
+		if status == nil || status.State != "ACTIVE" {
+			return resource.RetryableError(fmt.Errorf("Waiting for async replication of disk %q to become ACTIVE", name))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceDiskAsyncReplicationRead(d, meta)
+}
+
+func resourceDiskAsyncReplicationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	disk, err := diskAsyncReplicationGetPrimary(d, config, userAgent)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DiskAsyncReplication %q", d.Id()))
+	}
+
+	// secondary_disk is unknown after import, the primary disk can only replicate to one disk at a time.
+	secondaryDisk := ""
+	if v, ok := d.GetOk("secondary_disk.0.disk"); ok {
+		secondaryDisk = v.(string)
+	}
+	key, status := findDiskAsyncReplicationStatus(disk, secondaryDisk)
+	if status == nil || status.State == "STOPPED" {
+		log.Printf("[WARN] Async replication of disk %q isn't running. Removing from state.", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	primaryPath, err := getRelativePath(disk.SelfLink)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("primary_disk", primaryPath); err != nil {
+		return fmt.Errorf("Error setting primary_disk: %s", err)
+	}
+	secondaryPath, err := getRelativePath(key)
+	if err != nil {
+		return err
+	}
+	secondary := []map[string]interface{}{{
+		"disk":  secondaryPath,
+		"state": status.State,
+	}}
+	if err := d.Set("secondary_disk", secondary); err != nil {
+		return fmt.Errorf("Error setting secondary_disk: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDiskAsyncReplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	regional, project, location, name, err := diskAsyncReplicationPrimary(d, config)
+	if err != nil {
+		return err
+	}
+
+	var op *compute.Operation
+	if regional {
+		op, err = config.NewComputeClient(userAgent).RegionDisks.StopAsyncReplication(project, location, name).Do()
+	} else {
+		op, err = config.NewComputeClient(userAgent).Disks.StopAsyncReplication(project, location, name).Do()
+	}
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DiskAsyncReplication %q", d.Id()))
+	}
+
+	waitErr := computeOperationWaitTime(config, op, project, "stopping async replication", userAgent, d.Timeout(schema.TimeoutDelete))
+	if waitErr != nil {
+		return waitErr
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDiskAsyncReplicationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("primary_disk", d.Id()); err != nil {
+		return nil, fmt.Errorf("Error setting primary_disk: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
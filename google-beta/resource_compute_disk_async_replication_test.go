@@ -0,0 +1,182 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	compute "google.golang.org/api/compute/v0.beta"
+)
+
+func TestFindDiskAsyncReplicationStatus(t *testing.T) {
+	disk := &compute.Disk{
+		ResourceStatus: &compute.DiskResourceStatus{
+			AsyncSecondaryDisks: map[string]compute.DiskResourceStatusAsyncReplicationStatus{
+				"https://www.googleapis.com/compute/beta/projects/my-project/zones/us-east1-b/disks/secondary": {
+					State: "ACTIVE",
+				},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		Disk      *compute.Disk
+		Secondary string
+		State     string
+	}{
+		"no resource status": {
+			Disk:      &compute.Disk{},
+			Secondary: "projects/my-project/zones/us-east1-b/disks/secondary",
+		},
+		"relative path": {
+			Disk:      disk,
+			Secondary: "projects/my-project/zones/us-east1-b/disks/secondary",
+			State:     "ACTIVE",
+		},
+		"self link": {
+			Disk:      disk,
+			Secondary: "https://www.googleapis.com/compute/v1/projects/my-project/zones/us-east1-b/disks/secondary",
+			State:     "ACTIVE",
+		},
+		"unknown secondary after import": {
+			Disk:  disk,
+			State: "ACTIVE",
+		},
+		"other disk": {
+			Disk:      disk,
+			Secondary: "projects/my-project/zones/us-east1-b/disks/other",
+		},
+	}
+
+	for tn, tc := range cases {
+		_, status := findDiskAsyncReplicationStatus(tc.Disk, tc.Secondary)
+		if tc.State == "" {
+			if status != nil {
+				t.Errorf("%s: expected no status, got %q", tn, status.State)
+			}
+			continue
+		}
+		if status == nil {
+			t.Errorf("%s: expected status %q, got none", tn, tc.State)
+			continue
+		}
+		if status.State != tc.State {
+			t.Errorf("%s: expected status %q, got %q", tn, tc.State, status.State)
+		}
+	}
+}
+
+func TestAccComputeDiskAsyncReplication_basic(t *testing.T) {
+	t.Parallel()
+
+	suffix := randString(t, 10)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDiskAsyncReplication_basic(suffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_disk_async_replication.replication", "secondary_disk.0.state", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_disk_async_replication.replication",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeDiskAsyncReplication_regional(t *testing.T) {
+	t.Parallel()
+
+	suffix := randString(t, 10)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDiskAsyncReplication_regional(suffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_disk_async_replication.replication", "secondary_disk.0.state", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_disk_async_replication.replication",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeDiskAsyncReplication_basic(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_disk" "primary" {
+  name = "tf-test-primary-%s"
+  type = "pd-ssd"
+  zone = "europe-west4-a"
+
+  physical_block_size_bytes = 4096
+}
+
+resource "google_compute_disk" "secondary" {
+  name = "tf-test-secondary-%s"
+  type = "pd-ssd"
+  zone = "europe-west3-a"
+
+  async_primary_disk {
+    disk = google_compute_disk.primary.id
+  }
+
+  physical_block_size_bytes = 4096
+}
+
+resource "google_compute_disk_async_replication" "replication" {
+  primary_disk = google_compute_disk.primary.id
+  secondary_disk {
+    disk = google_compute_disk.secondary.id
+  }
+}
+`, suffix, suffix)
+}
+
+func testAccComputeDiskAsyncReplication_regional(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_region_disk" "primary" {
+  name   = "tf-test-primary-%s"
+  type   = "pd-ssd"
+  region = "europe-west4"
+
+  physical_block_size_bytes = 4096
+
+  replica_zones = ["europe-west4-a", "europe-west4-b"]
+}
+
+resource "google_compute_region_disk" "secondary" {
+  name   = "tf-test-secondary-%s"
+  type   = "pd-ssd"
+  region = "europe-west3"
+
+  async_primary_disk {
+    disk = google_compute_region_disk.primary.id
+  }
+
+  physical_block_size_bytes = 4096
+
+  replica_zones = ["europe-west3-a", "europe-west3-b"]
+}
+
+resource "google_compute_disk_async_replication" "replication" {
+  primary_disk = google_compute_region_disk.primary.id
+  secondary_disk {
+    disk = google_compute_region_disk.secondary.id
+  }
+}
+`, suffix, suffix)
+}
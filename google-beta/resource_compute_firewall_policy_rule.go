@@ -1,32 +1,17 @@
-// ----------------------------------------------------------------------------
-//
-//     ***     AUTO GENERATED CODE    ***    Type: DCL     ***
-//
-// ----------------------------------------------------------------------------
-//
-//     This file is managed by Magic Modules (https://github.com/GoogleCloudPlatform/magic-modules)
-//     and is based on the DCL (https://github.com/GoogleCloudPlatform/declarative-resource-client-library).
-//     Changes will need to be made to the DCL or Magic Modules instead of here.
-//
-//     We are not currently able to accept contributions to this file. If changes
-//     are required, please file an issue at https://github.com/hashicorp/terraform-provider-google/issues/new/choose
-//
-// ----------------------------------------------------------------------------
-
 package google
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	dcl "github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
-	compute "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
+	compute "google.golang.org/api/compute/v0.beta"
 )
 
+// google_compute_firewall_policy_rule used to be generated from the DCL. It
+// keeps the schema and the ID of the DCL resource, so that existing state and
+// import IDs keep working with the Compute API.
 func resourceComputeFirewallPolicyRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeFirewallPolicyRuleCreate,
@@ -70,7 +55,7 @@ func resourceComputeFirewallPolicyRule() *schema.Resource {
 				Required:    true,
 				Description: "A match condition that incoming traffic is evaluated against. If it evaluates to true, the corresponding 'action' is enforced.",
 				MaxItems:    1,
-				Elem:        ComputeFirewallPolicyRuleMatchSchema(),
+				Elem:        computeFirewallPolicyRuleMatchSchema(),
 			},
 
 			"priority": {
@@ -128,268 +113,185 @@ func resourceComputeFirewallPolicyRule() *schema.Resource {
 	}
 }
 
-func ComputeFirewallPolicyRuleMatchSchema() *schema.Resource {
-	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"layer4_configs": {
-				Type:        schema.TypeList,
-				Required:    true,
-				Description: "Pairs of IP protocols and ports that the rule should match.",
-				Elem:        ComputeFirewallPolicyRuleMatchLayer4ConfigsSchema(),
-			},
-
-			"dest_ip_ranges": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "CIDR IP address range. Maximum number of destination CIDR IP ranges allowed is 256.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
-			},
+func computeFirewallPolicyRuleMatchSchema() *schema.Resource {
+	// Organization firewall policy rules match on the same fields as network
+	// firewall policy rules, except for secure tags.
+	match := computeNetworkFirewallPolicyRuleMatchSchema()
+	delete(match.Schema, "src_secure_tags")
+	return match
+}
 
-			"src_ip_ranges": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "CIDR IP address range. Maximum number of source CIDR IP ranges allowed is 256.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
-			},
-		},
+func expandComputeFirewallPolicyRule(d *schema.ResourceData) *compute.FirewallPolicyRule {
+	return &compute.FirewallPolicyRule{
+		Priority:              int64(d.Get("priority").(int)),
+		Action:                d.Get("action").(string),
+		Direction:             d.Get("direction").(string),
+		Match:                 expandComputeNetworkFirewallPolicyRuleMatch(d.Get("match").([]interface{})),
+		Description:           d.Get("description").(string),
+		Disabled:              d.Get("disabled").(bool),
+		EnableLogging:         d.Get("enable_logging").(bool),
+		TargetResources:       convertStringArr(d.Get("target_resources").([]interface{})),
+		TargetServiceAccounts: convertStringArr(d.Get("target_service_accounts").([]interface{})),
+		// Send the empty values so that patching a rule clears the removed fields
+		ForceSendFields: []string{"Description", "Disabled", "EnableLogging", "TargetResources", "TargetServiceAccounts"},
 	}
 }
 
-func ComputeFirewallPolicyRuleMatchLayer4ConfigsSchema() *schema.Resource {
-	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"ip_protocol": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The IP protocol to which this rule applies. The protocol type is required when creating a firewall rule. This value can either be one of the following well known protocol strings (`tcp`, `udp`, `icmp`, `esp`, `ah`, `ipip`, `sctp`), or the IP protocol number.",
-			},
-
-			"ports": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "An optional list of ports to which this rule applies. This field is only applicable for UDP or TCP protocol. Each entry must be either an integer or a range. If not specified, this rule applies to connections through any port. Example inputs include: ``.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
-			},
-		},
+func flattenComputeFirewallPolicyRuleMatch(match *compute.FirewallPolicyRuleMatcher) []map[string]interface{} {
+	flattened := flattenComputeNetworkFirewallPolicyRuleMatch(match)
+	for _, m := range flattened {
+		delete(m, "src_secure_tags")
 	}
+	return flattened
 }
 
-func resourceComputeFirewallPolicyRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*Config)
-
-	obj := &compute.FirewallPolicyRule{
-		Action:                dcl.String(d.Get("action").(string)),
-		Direction:             compute.FirewallPolicyRuleDirectionEnumRef(d.Get("direction").(string)),
-		FirewallPolicy:        dcl.String(d.Get("firewall_policy").(string)),
-		Match:                 expandComputeFirewallPolicyRuleMatch(d.Get("match")),
-		Priority:              dcl.Int64(int64(d.Get("priority").(int))),
-		Description:           dcl.String(d.Get("description").(string)),
-		Disabled:              dcl.Bool(d.Get("disabled").(bool)),
-		EnableLogging:         dcl.Bool(d.Get("enable_logging").(bool)),
-		TargetResources:       expandStringArray(d.Get("target_resources")),
-		TargetServiceAccounts: expandStringArray(d.Get("target_service_accounts")),
+// modifyComputeFirewallPolicy runs f against the firewall policy while holding
+// its lock and waits for the organization operation it returns.
+func modifyComputeFirewallPolicy(d *schema.ResourceData, config *Config, userAgent, activity string, timeout time.Duration, f func(client *compute.Service, policy string) (*compute.Operation, error)) error {
+	policy := GetResourceNameFromSelfLink(d.Get("firewall_policy").(string))
+	lockName := "firewallPolicy/" + policy
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	client := config.NewComputeClient(userAgent)
+	// `parent` is needed to poll the asynchronous operations but its available only on the policy.
+	fp, err := client.FirewallPolicies.Get(policy).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading firewall policy %q: %s", policy, err)
 	}
 
-	id, err := obj.ID()
+	op, err := f(client, policy)
 	if err != nil {
-		return fmt.Errorf("error constructing id: %s", err)
+		return fmt.Errorf("Error %s: %s", activity, err)
 	}
-	d.SetId(id)
-	directive := CreateDirective
+
+	var opRes map[string]interface{}
+	return computeOrgOperationWaitTimeWithResponse(config, op, &opRes, fp.Parent, activity, userAgent, timeout)
+}
+
+func resourceComputeFirewallPolicyRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
 	userAgent, err := generateUserAgentString(d, config.userAgent)
 	if err != nil {
 		return err
 	}
-	billingProject := ""
-	// err == nil indicates that the billing_project value was found
-	if bp, err := getBillingProject(d, config); err == nil {
-		billingProject = bp
-	}
-	client := NewDCLComputeClient(config, userAgent, billingProject, d.Timeout(schema.TimeoutCreate))
-	if bp, err := replaceVars(d, config, client.Config.BasePath); err != nil {
-		d.SetId("")
-		return fmt.Errorf("Could not format %q: %w", client.Config.BasePath, err)
-	} else {
-		client.Config.BasePath = bp
-	}
-	res, err := client.ApplyFirewallPolicyRule(context.Background(), obj, directive...)
-
-	if _, ok := err.(dcl.DiffAfterApplyError); ok {
-		log.Printf("[DEBUG] Diff after apply returned from the DCL: %s", err)
-	} else if err != nil {
-		// The resource didn't actually create
-		d.SetId("")
-		return fmt.Errorf("Error creating FirewallPolicyRule: %s", err)
+
+	policy := GetResourceNameFromSelfLink(d.Get("firewall_policy").(string))
+	rule := expandComputeFirewallPolicyRule(d)
+	log.Printf("[DEBUG] Adding rule %d to firewall policy %q: %#v", rule.Priority, policy, rule)
+
+	activity := fmt.Sprintf("adding rule %d to firewall policy %q", rule.Priority, policy)
+	err = modifyComputeFirewallPolicy(d, config, userAgent, activity, d.Timeout(schema.TimeoutCreate), func(client *compute.Service, policy string) (*compute.Operation, error) {
+		return client.FirewallPolicies.AddRule(policy, rule).Do()
+	})
+	if err != nil {
+		return err
 	}
 
-	log.Printf("[DEBUG] Finished creating FirewallPolicyRule %q: %#v", d.Id(), res)
+	d.SetId(fmt.Sprintf("locations/global/firewallPolicies/%s/rules/%d", policy, rule.Priority))
 
 	return resourceComputeFirewallPolicyRuleRead(d, meta)
 }
 
 func resourceComputeFirewallPolicyRuleRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-
-	obj := &compute.FirewallPolicyRule{
-		Action:                dcl.String(d.Get("action").(string)),
-		Direction:             compute.FirewallPolicyRuleDirectionEnumRef(d.Get("direction").(string)),
-		FirewallPolicy:        dcl.String(d.Get("firewall_policy").(string)),
-		Match:                 expandComputeFirewallPolicyRuleMatch(d.Get("match")),
-		Priority:              dcl.Int64(int64(d.Get("priority").(int))),
-		Description:           dcl.String(d.Get("description").(string)),
-		Disabled:              dcl.Bool(d.Get("disabled").(bool)),
-		EnableLogging:         dcl.Bool(d.Get("enable_logging").(bool)),
-		TargetResources:       expandStringArray(d.Get("target_resources")),
-		TargetServiceAccounts: expandStringArray(d.Get("target_service_accounts")),
-	}
-
 	userAgent, err := generateUserAgentString(d, config.userAgent)
 	if err != nil {
 		return err
 	}
-	billingProject := ""
-	// err == nil indicates that the billing_project value was found
-	if bp, err := getBillingProject(d, config); err == nil {
-		billingProject = bp
-	}
-	client := NewDCLComputeClient(config, userAgent, billingProject, d.Timeout(schema.TimeoutRead))
-	if bp, err := replaceVars(d, config, client.Config.BasePath); err != nil {
-		d.SetId("")
-		return fmt.Errorf("Could not format %q: %w", client.Config.BasePath, err)
-	} else {
-		client.Config.BasePath = bp
-	}
-	res, err := client.GetFirewallPolicyRule(context.Background(), obj)
+
+	policy := GetResourceNameFromSelfLink(d.Get("firewall_policy").(string))
+	priority := int64(d.Get("priority").(int))
+	rule, err := config.NewComputeClient(userAgent).FirewallPolicies.GetRule(policy).Priority(priority).Do()
 	if err != nil {
-		resourceName := fmt.Sprintf("ComputeFirewallPolicyRule %q", d.Id())
-		return handleNotFoundDCLError(err, d, resourceName)
+		// The API answers with a 400 rather than a 404 for a priority without a rule
+		if isGoogleApiErrorWithCode(err, 400) {
+			log.Printf("[WARN] Removing firewall policy rule %q because it's gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeFirewallPolicyRule %q", d.Id()))
 	}
 
-	if err = d.Set("action", res.Action); err != nil {
-		return fmt.Errorf("error setting action in state: %s", err)
-	}
-	if err = d.Set("direction", res.Direction); err != nil {
-		return fmt.Errorf("error setting direction in state: %s", err)
+	if err := d.Set("action", rule.Action); err != nil {
+		return fmt.Errorf("Error setting action: %s", err)
 	}
-	if err = d.Set("firewall_policy", res.FirewallPolicy); err != nil {
-		return fmt.Errorf("error setting firewall_policy in state: %s", err)
+	if err := d.Set("direction", rule.Direction); err != nil {
+		return fmt.Errorf("Error setting direction: %s", err)
 	}
-	if err = d.Set("match", flattenComputeFirewallPolicyRuleMatch(res.Match)); err != nil {
-		return fmt.Errorf("error setting match in state: %s", err)
+	if err := d.Set("match", flattenComputeFirewallPolicyRuleMatch(rule.Match)); err != nil {
+		return fmt.Errorf("Error setting match: %s", err)
 	}
-	if err = d.Set("priority", res.Priority); err != nil {
-		return fmt.Errorf("error setting priority in state: %s", err)
+	if err := d.Set("priority", rule.Priority); err != nil {
+		return fmt.Errorf("Error setting priority: %s", err)
 	}
-	if err = d.Set("description", res.Description); err != nil {
-		return fmt.Errorf("error setting description in state: %s", err)
+	if err := d.Set("description", rule.Description); err != nil {
+		return fmt.Errorf("Error setting description: %s", err)
 	}
-	if err = d.Set("disabled", res.Disabled); err != nil {
-		return fmt.Errorf("error setting disabled in state: %s", err)
+	if err := d.Set("disabled", rule.Disabled); err != nil {
+		return fmt.Errorf("Error setting disabled: %s", err)
 	}
-	if err = d.Set("enable_logging", res.EnableLogging); err != nil {
-		return fmt.Errorf("error setting enable_logging in state: %s", err)
+	if err := d.Set("enable_logging", rule.EnableLogging); err != nil {
+		return fmt.Errorf("Error setting enable_logging: %s", err)
 	}
-	if err = d.Set("target_resources", res.TargetResources); err != nil {
-		return fmt.Errorf("error setting target_resources in state: %s", err)
+	if err := d.Set("target_resources", rule.TargetResources); err != nil {
+		return fmt.Errorf("Error setting target_resources: %s", err)
 	}
-	if err = d.Set("target_service_accounts", res.TargetServiceAccounts); err != nil {
-		return fmt.Errorf("error setting target_service_accounts in state: %s", err)
+	if err := d.Set("target_service_accounts", rule.TargetServiceAccounts); err != nil {
+		return fmt.Errorf("Error setting target_service_accounts: %s", err)
 	}
-	if err = d.Set("kind", res.Kind); err != nil {
-		return fmt.Errorf("error setting kind in state: %s", err)
+	if err := d.Set("kind", rule.Kind); err != nil {
+		return fmt.Errorf("Error setting kind: %s", err)
 	}
-	if err = d.Set("rule_tuple_count", res.RuleTupleCount); err != nil {
-		return fmt.Errorf("error setting rule_tuple_count in state: %s", err)
+	if err := d.Set("rule_tuple_count", rule.RuleTupleCount); err != nil {
+		return fmt.Errorf("Error setting rule_tuple_count: %s", err)
 	}
 
 	return nil
 }
+
 func resourceComputeFirewallPolicyRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-
-	obj := &compute.FirewallPolicyRule{
-		Action:                dcl.String(d.Get("action").(string)),
-		Direction:             compute.FirewallPolicyRuleDirectionEnumRef(d.Get("direction").(string)),
-		FirewallPolicy:        dcl.String(d.Get("firewall_policy").(string)),
-		Match:                 expandComputeFirewallPolicyRuleMatch(d.Get("match")),
-		Priority:              dcl.Int64(int64(d.Get("priority").(int))),
-		Description:           dcl.String(d.Get("description").(string)),
-		Disabled:              dcl.Bool(d.Get("disabled").(bool)),
-		EnableLogging:         dcl.Bool(d.Get("enable_logging").(bool)),
-		TargetResources:       expandStringArray(d.Get("target_resources")),
-		TargetServiceAccounts: expandStringArray(d.Get("target_service_accounts")),
-	}
-	directive := UpdateDirective
 	userAgent, err := generateUserAgentString(d, config.userAgent)
 	if err != nil {
 		return err
 	}
 
-	billingProject := ""
-	// err == nil indicates that the billing_project value was found
-	if bp, err := getBillingProject(d, config); err == nil {
-		billingProject = bp
-	}
-	client := NewDCLComputeClient(config, userAgent, billingProject, d.Timeout(schema.TimeoutUpdate))
-	if bp, err := replaceVars(d, config, client.Config.BasePath); err != nil {
-		d.SetId("")
-		return fmt.Errorf("Could not format %q: %w", client.Config.BasePath, err)
-	} else {
-		client.Config.BasePath = bp
-	}
-	res, err := client.ApplyFirewallPolicyRule(context.Background(), obj, directive...)
-
-	if _, ok := err.(dcl.DiffAfterApplyError); ok {
-		log.Printf("[DEBUG] Diff after apply returned from the DCL: %s", err)
-	} else if err != nil {
-		// The resource didn't actually create
-		d.SetId("")
-		return fmt.Errorf("Error updating FirewallPolicyRule: %s", err)
-	}
+	policy := GetResourceNameFromSelfLink(d.Get("firewall_policy").(string))
+	rule := expandComputeFirewallPolicyRule(d)
+	log.Printf("[DEBUG] Updating rule %d of firewall policy %q: %#v", rule.Priority, policy, rule)
 
-	log.Printf("[DEBUG] Finished creating FirewallPolicyRule %q: %#v", d.Id(), res)
+	activity := fmt.Sprintf("updating rule %d of firewall policy %q", rule.Priority, policy)
+	err = modifyComputeFirewallPolicy(d, config, userAgent, activity, d.Timeout(schema.TimeoutUpdate), func(client *compute.Service, policy string) (*compute.Operation, error) {
+		return client.FirewallPolicies.PatchRule(policy, rule).Priority(rule.Priority).Do()
+	})
+	if err != nil {
+		return err
+	}
 
 	return resourceComputeFirewallPolicyRuleRead(d, meta)
 }
 
 func resourceComputeFirewallPolicyRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-
-	obj := &compute.FirewallPolicyRule{
-		Action:                dcl.String(d.Get("action").(string)),
-		Direction:             compute.FirewallPolicyRuleDirectionEnumRef(d.Get("direction").(string)),
-		FirewallPolicy:        dcl.String(d.Get("firewall_policy").(string)),
-		Match:                 expandComputeFirewallPolicyRuleMatch(d.Get("match")),
-		Priority:              dcl.Int64(int64(d.Get("priority").(int))),
-		Description:           dcl.String(d.Get("description").(string)),
-		Disabled:              dcl.Bool(d.Get("disabled").(bool)),
-		EnableLogging:         dcl.Bool(d.Get("enable_logging").(bool)),
-		TargetResources:       expandStringArray(d.Get("target_resources")),
-		TargetServiceAccounts: expandStringArray(d.Get("target_service_accounts")),
-	}
-
-	log.Printf("[DEBUG] Deleting FirewallPolicyRule %q", d.Id())
 	userAgent, err := generateUserAgentString(d, config.userAgent)
 	if err != nil {
 		return err
 	}
-	billingProject := ""
-	// err == nil indicates that the billing_project value was found
-	if bp, err := getBillingProject(d, config); err == nil {
-		billingProject = bp
-	}
-	client := NewDCLComputeClient(config, userAgent, billingProject, d.Timeout(schema.TimeoutDelete))
-	if bp, err := replaceVars(d, config, client.Config.BasePath); err != nil {
-		d.SetId("")
-		return fmt.Errorf("Could not format %q: %w", client.Config.BasePath, err)
-	} else {
-		client.Config.BasePath = bp
-	}
-	if err := client.DeleteFirewallPolicyRule(context.Background(), obj); err != nil {
-		return fmt.Errorf("Error deleting FirewallPolicyRule: %s", err)
+
+	policy := GetResourceNameFromSelfLink(d.Get("firewall_policy").(string))
+	priority := int64(d.Get("priority").(int))
+	log.Printf("[DEBUG] Removing rule %d from firewall policy %q", priority, policy)
+
+	activity := fmt.Sprintf("removing rule %d from firewall policy %q", priority, policy)
+	err = modifyComputeFirewallPolicy(d, config, userAgent, activity, d.Timeout(schema.TimeoutDelete), func(client *compute.Service, policy string) (*compute.Operation, error) {
+		return client.FirewallPolicies.RemoveRule(policy).Priority(priority).Do()
+	})
+	if err != nil {
+		return err
 	}
 
-	log.Printf("[DEBUG] Finished deleting FirewallPolicyRule %q", d.Id())
+	d.SetId("")
 	return nil
 }
 
@@ -412,90 +314,3 @@ func resourceComputeFirewallPolicyRuleImport(d *schema.ResourceData, meta interf
 
 	return []*schema.ResourceData{d}, nil
 }
-
-func expandComputeFirewallPolicyRuleMatch(o interface{}) *compute.FirewallPolicyRuleMatch {
-	if o == nil {
-		return compute.EmptyFirewallPolicyRuleMatch
-	}
-	objArr := o.([]interface{})
-	if len(objArr) == 0 || objArr[0] == nil {
-		return compute.EmptyFirewallPolicyRuleMatch
-	}
-	obj := objArr[0].(map[string]interface{})
-	return &compute.FirewallPolicyRuleMatch{
-		Layer4Configs: expandComputeFirewallPolicyRuleMatchLayer4ConfigsArray(obj["layer4_configs"]),
-		DestIPRanges:  expandStringArray(obj["dest_ip_ranges"]),
-		SrcIPRanges:   expandStringArray(obj["src_ip_ranges"]),
-	}
-}
-
-func flattenComputeFirewallPolicyRuleMatch(obj *compute.FirewallPolicyRuleMatch) interface{} {
-	if obj == nil || obj.Empty() {
-		return nil
-	}
-	transformed := map[string]interface{}{
-		"layer4_configs": flattenComputeFirewallPolicyRuleMatchLayer4ConfigsArray(obj.Layer4Configs),
-		"dest_ip_ranges": obj.DestIPRanges,
-		"src_ip_ranges":  obj.SrcIPRanges,
-	}
-
-	return []interface{}{transformed}
-
-}
-func expandComputeFirewallPolicyRuleMatchLayer4ConfigsArray(o interface{}) []compute.FirewallPolicyRuleMatchLayer4Configs {
-	if o == nil {
-		return make([]compute.FirewallPolicyRuleMatchLayer4Configs, 0)
-	}
-
-	objs := o.([]interface{})
-	if len(objs) == 0 || objs[0] == nil {
-		return make([]compute.FirewallPolicyRuleMatchLayer4Configs, 0)
-	}
-
-	items := make([]compute.FirewallPolicyRuleMatchLayer4Configs, 0, len(objs))
-	for _, item := range objs {
-		i := expandComputeFirewallPolicyRuleMatchLayer4Configs(item)
-		items = append(items, *i)
-	}
-
-	return items
-}
-
-func expandComputeFirewallPolicyRuleMatchLayer4Configs(o interface{}) *compute.FirewallPolicyRuleMatchLayer4Configs {
-	if o == nil {
-		return compute.EmptyFirewallPolicyRuleMatchLayer4Configs
-	}
-
-	obj := o.(map[string]interface{})
-	return &compute.FirewallPolicyRuleMatchLayer4Configs{
-		IPProtocol: dcl.String(obj["ip_protocol"].(string)),
-		Ports:      expandStringArray(obj["ports"]),
-	}
-}
-
-func flattenComputeFirewallPolicyRuleMatchLayer4ConfigsArray(objs []compute.FirewallPolicyRuleMatchLayer4Configs) []interface{} {
-	if objs == nil {
-		return nil
-	}
-
-	items := []interface{}{}
-	for _, item := range objs {
-		i := flattenComputeFirewallPolicyRuleMatchLayer4Configs(&item)
-		items = append(items, i)
-	}
-
-	return items
-}
-
-func flattenComputeFirewallPolicyRuleMatchLayer4Configs(obj *compute.FirewallPolicyRuleMatchLayer4Configs) interface{} {
-	if obj == nil || obj.Empty() {
-		return nil
-	}
-	transformed := map[string]interface{}{
-		"ip_protocol": obj.IPProtocol,
-		"ports":       obj.Ports,
-	}
-
-	return transformed
-
-}
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccComputeFirewallPolicyRule_update(t *testing.T) {
@@ -63,6 +65,74 @@ func TestAccComputeFirewallPolicyRule_update(t *testing.T) {
 	})
 }
 
+// The resource used to be generated from the DCL. State written by the last
+// release still using the DCL must be read without a diff by the Compute API
+// implementation, and keep working for updates and imports.
+func TestAccComputeFirewallPolicyRule_upgradeFromDcl(t *testing.T) {
+	// The released provider's requests can't be recorded
+	skipIfVcr(t)
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+		"org_name":      fmt.Sprintf("organizations/%s", getTestOrgFromEnv(t)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"google-beta": {
+				Source:            "hashicorp/google-beta",
+				VersionConstraint: "4.32.0",
+			},
+		},
+		Providers: map[string]*schema.Provider{
+			"google-local": testAccProvider,
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: reformConfigWithProvider(testAccComputeFirewallPolicyRule_start(context), "google-beta"),
+			},
+			{
+				Config:             reformConfigWithProvider(testAccComputeFirewallPolicyRule_start(context), "google-local"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config: reformConfigWithProvider(testAccComputeFirewallPolicyRule_update(context), "google-local"),
+			},
+			{
+				ResourceName:      "google_compute_firewall_policy_rule.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Referencing using ID causes import to fail
+				ImportStateVerifyIgnore: []string{"firewall_policy", "target_resources"},
+			},
+			{
+				ResourceName:      "google_compute_firewall_policy_rule.default",
+				ImportState:       true,
+				ImportStateIdFunc: testAccComputeFirewallPolicyRuleShortImportId("google_compute_firewall_policy_rule.default"),
+				ImportStateVerify: true,
+				// Referencing using ID causes import to fail
+				ImportStateVerifyIgnore: []string{"firewall_policy", "target_resources"},
+			},
+		},
+	})
+}
+
+// testAccComputeFirewallPolicyRuleShortImportId returns the {{firewall_policy}}/{{priority}}
+// import ID of the rule.
+func testAccComputeFirewallPolicyRuleShortImportId(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+		policy := GetResourceNameFromSelfLink(rs.Primary.Attributes["firewall_policy"])
+		return fmt.Sprintf("%s/%s", policy, rs.Primary.Attributes["priority"]), nil
+	}
+}
+
 func testAccComputeFirewallPolicyRule_start(context map[string]interface{}) string {
 	return Nprintf(`
 resource "google_service_account" "service_account" {
@@ -435,3 +505,94 @@ resource "google_compute_firewall_policy_rule" "rule3" {
 }
 `, context)
 }
+
+func TestAccComputeFirewallPolicyRule_addressGroups(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+		"org_name":      fmt.Sprintf("organizations/%s", getTestOrgFromEnv(t)),
+		"org_id":        getTestOrgFromEnv(t),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeFirewallPolicyRule_addressGroups(context),
+			},
+			{
+				ResourceName:      "google_compute_firewall_policy_rule.ingress",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Referencing using ID causes import to fail
+				ImportStateVerifyIgnore: []string{"firewall_policy"},
+			},
+			{
+				ResourceName:      "google_compute_firewall_policy_rule.egress",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Referencing using ID causes import to fail
+				ImportStateVerifyIgnore: []string{"firewall_policy"},
+			},
+		},
+	})
+}
+
+func testAccComputeFirewallPolicyRule_addressGroups(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_network_security_address_group" "partners" {
+  name     = "tf-test-partners-%{random_suffix}"
+  parent   = "organizations/%{org_id}"
+  location = "global"
+  type     = "IPV4"
+  capacity = 100
+  items    = ["208.80.154.224/32"]
+}
+
+resource "google_folder" "folder" {
+  display_name = "tf-test-folder-%{random_suffix}"
+  parent       = "%{org_name}"
+}
+
+resource "google_compute_firewall_policy" "default" {
+  parent      = google_folder.folder.name
+  short_name  = "tf-test-policy-%{random_suffix}"
+  description = "Resource created for Terraform acceptance testing"
+}
+
+resource "google_compute_firewall_policy_rule" "ingress" {
+  firewall_policy = google_compute_firewall_policy.default.id
+  priority        = 9000
+  action          = "allow"
+  direction       = "INGRESS"
+  match {
+    layer4_configs {
+      ip_protocol = "tcp"
+      ports       = [443]
+    }
+    src_address_groups       = [google_network_security_address_group.partners.id]
+    src_fqdns                = ["example.com"]
+    src_region_codes         = ["US"]
+    src_threat_intelligences = ["iplist-known-malicious-ips"]
+  }
+}
+
+resource "google_compute_firewall_policy_rule" "egress" {
+  firewall_policy = google_compute_firewall_policy.default.id
+  priority        = 9001
+  action          = "deny"
+  direction       = "EGRESS"
+  match {
+    layer4_configs {
+      ip_protocol = "all"
+    }
+    dest_address_groups       = [google_network_security_address_group.partners.id]
+    dest_fqdns                = ["example.org"]
+    dest_region_codes         = ["US"]
+    dest_threat_intelligences = ["iplist-known-malicious-ips"]
+  }
+}
+`, context)
+}
@@ -40,6 +40,8 @@ func resourceComputeGlobalAddress() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: resourceComputeGlobalAddressCidrOverlapCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -150,6 +150,16 @@ This is provided by the client when the disk image is created.`,
 You must provide either this property or the
 rawDisk.source property but not both to create an image.`,
 			},
+			"source_file": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"raw_disk", "source_disk", "source_image", "source_snapshot"},
+				Description: `A local disk file to create the image from. The file is uploaded to a staging bucket
+as the tar.gz archive the API expects, and the staging object is deleted once the image is created.`,
+				Elem: computeImageSourceFileSchema(),
+			},
 			"source_image": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -305,6 +315,17 @@ func resourceComputeImageCreate(d *schema.ResourceData, meta interface{}) error
 		obj["sourceSnapshot"] = sourceSnapshotProp
 	}
 
+	sourceFileHash := ""
+	if _, ok := d.GetOk("source_file"); ok {
+		rawDisk, hash, cleanup, err := stageComputeImageSourceFile(d, config, userAgent)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		obj["rawDisk"] = rawDisk
+		sourceFileHash = hash
+	}
+
 	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/images")
 	if err != nil {
 		return err
@@ -348,6 +369,14 @@ func resourceComputeImageCreate(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Finished creating Image %q: %#v", d.Id(), res)
 
+	if sourceFileHash != "" {
+		sourceFile := d.Get("source_file").([]interface{})[0].(map[string]interface{})
+		sourceFile["content_hash"] = sourceFileHash
+		if err := d.Set("source_file", []interface{}{sourceFile}); err != nil {
+			return fmt.Errorf("Error setting source_file: %s", err)
+		}
+	}
+
 	return resourceComputeImageRead(d, meta)
 }
 
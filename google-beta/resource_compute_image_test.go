@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -98,6 +99,44 @@ func TestAccComputeImage_basedondisk(t *testing.T) {
 	})
 }
 
+func TestAccComputeImage_sourceFile(t *testing.T) {
+	// Uploads a local file, which can't be replayed
+	skipIfVcr(t)
+	t.Parallel()
+
+	var image compute.Image
+
+	// Raw disks need to be a multiple of 1 GiB, a sparse file keeps the test cheap.
+	diskFile := getNewTmpTestFile(t, "tf-test-disk")
+	defer os.Remove(diskFile.Name())
+	if err := diskFile.Truncate(1 << 30); err != nil {
+		t.Fatalf("Cannot resize temp file: %s", err)
+	}
+	diskFile.Close()
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeImageDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeImage_sourceFile(randString(t, 10), randString(t, 10), diskFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeImageExists(
+						t, "google_compute_image.foobar", &image),
+					resource.TestCheckResourceAttr("google_compute_image.foobar", "source_file.0.content_hash", getFileSha256Hash(diskFile.Name())),
+				),
+			},
+			{
+				ResourceName:            "google_compute_image.foobar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_file"},
+			},
+		},
+	})
+}
+
 func TestAccComputeImage_sourceImage(t *testing.T) {
 	t.Parallel()
 
@@ -409,6 +448,25 @@ resource "google_compute_image" "foobar" {
 `, diskName, imageName)
 }
 
+func testAccComputeImage_sourceFile(bucketName, imageName, path string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "staging" {
+  name          = "tf-test-image-staging-%s"
+  location      = "US"
+  force_destroy = true
+}
+
+resource "google_compute_image" "foobar" {
+  name = "image-test-%s"
+
+  source_file {
+    path           = "%s"
+    staging_bucket = google_storage_bucket.staging.name
+  }
+}
+`, bucketName, imageName, path)
+}
+
 func testAccComputeImage_sourceImage(imageName string) string {
 	return fmt.Sprintf(`
 data "google_compute_image" "my_image" {
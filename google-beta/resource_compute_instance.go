@@ -37,6 +37,8 @@ var (
 		"boot_disk.0.initialize_params.0.type",
 		"boot_disk.0.initialize_params.0.image",
 		"boot_disk.0.initialize_params.0.labels",
+		"boot_disk.0.initialize_params.0.provisioned_iops",
+		"boot_disk.0.initialize_params.0.provisioned_throughput",
 	}
 
 	schedulingKeys = []string{
@@ -88,6 +90,70 @@ func forceNewIfNetworkIPNotUpdatableFunc(d TerraformResourceDiff) error {
 	return nil
 }
 
+// boot_disk.0.initialize_params.0.size is resized in place, and disks can only
+// grow. Reject decreases unless the boot disk is replaced anyway.
+func rejectBootDiskSizeDecrease(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return rejectBootDiskSizeDecreaseFunc(d)
+}
+
+func rejectBootDiskSizeDecreaseFunc(d TerraformResourceDiff) error {
+	sizeKey := "boot_disk.0.initialize_params.0.size"
+	if !d.HasChange(sizeKey) {
+		return nil
+	}
+
+	o, n := d.GetChange(sizeKey)
+	oldSize, _ := o.(int)
+	newSize, _ := n.(int)
+	if oldSize == 0 || newSize == 0 || newSize >= oldSize {
+		return nil
+	}
+
+	// A replaced instance gets a brand new boot disk, so any size is fine.
+	if hasForceNewChange(d, "", resourceComputeInstance().Schema, sizeKey) {
+		return nil
+	}
+
+	return fmt.Errorf("boot_disk.0.initialize_params.0.size can't be decreased from %d to %d, disks can only be resized to a larger size. To shrink the boot disk, replace the instance explicitly (e.g. with `terraform apply -replace`)", oldSize, newSize)
+}
+
+// hasForceNewChange reports whether any ForceNew field under prefix, other
+// than skip, changes in the diff, i.e. whether the resource will be replaced.
+func hasForceNewChange(d TerraformResourceDiff, prefix string, s map[string]*schema.Schema, skip string) bool {
+	for k, v := range s {
+		key := prefix + k
+		if key == skip {
+			continue
+		}
+		if elem, ok := v.Elem.(*schema.Resource); ok && v.Type == schema.TypeList {
+			if v.ForceNew && d.HasChange(key+".#") {
+				return true
+			}
+			count := 1
+			if v.MaxItems != 1 {
+				o, n := d.GetChange(key + ".#")
+				oldCount, _ := o.(int)
+				newCount, _ := n.(int)
+				count = oldCount
+				if newCount > count {
+					count = newCount
+				}
+			}
+			for i := 0; i < count; i++ {
+				if hasForceNewChange(d, fmt.Sprintf("%s.%d.", key, i), elem.Schema, skip) {
+					return true
+				}
+			}
+			continue
+		}
+		if v.ForceNew && d.HasChange(key) {
+			return true
+		}
+	}
+	return false
+}
+
 func resourceComputeInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeInstanceCreate,
@@ -169,7 +235,6 @@ func resourceComputeInstance() *schema.Resource {
 							Optional:     true,
 							AtLeastOneOf: bootDiskKeys,
 							Computed:     true,
-							ForceNew:     true,
 							MaxItems:     1,
 							Description:  `Parameters with which a disk was created alongside the instance.`,
 							Elem: &schema.Resource{
@@ -179,9 +244,8 @@ func resourceComputeInstance() *schema.Resource {
 										Optional:     true,
 										AtLeastOneOf: initializeParamsKeys,
 										Computed:     true,
-										ForceNew:     true,
 										ValidateFunc: validation.IntAtLeast(1),
-										Description:  `The size of the image in gigabytes.`,
+										Description:  `The size of the image in gigabytes. Increasing the size resizes the disk in place, it can't be decreased.`,
 									},
 
 									"type": {
@@ -211,6 +275,22 @@ func resourceComputeInstance() *schema.Resource {
 										ForceNew:     true,
 										Description:  `A set of key/value label pairs assigned to the disk.`,
 									},
+
+									"provisioned_iops": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										AtLeastOneOf: initializeParamsKeys,
+										Computed:     true,
+										Description:  `Indicates how many IOPS to provision for the disk. This sets the number of I/O operations per second that the disk can handle. Can be updated in place.`,
+									},
+
+									"provisioned_throughput": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										AtLeastOneOf: initializeParamsKeys,
+										Computed:     true,
+										Description:  `Indicates how much throughput to provision for the disk, in MB/s. Can be updated in place.`,
+									},
 								},
 							},
 						},
@@ -815,6 +895,8 @@ func resourceComputeInstance() *schema.Resource {
 				Description: `The unique fingerprint of the metadata.`,
 			},
 
+			"preflight_quota_warning": computeQuotaPreflightWarningSchema(),
+
 			"self_link": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -898,6 +980,8 @@ func resourceComputeInstance() *schema.Resource {
 			),
 			desiredStatusDiff,
 			forceNewIfNetworkIPNotUpdatable,
+			rejectBootDiskSizeDecrease,
+			computeInstanceQuotaPreflight,
 		),
 		UseJSONNumber: true,
 	}
@@ -1372,6 +1456,10 @@ func resourceComputeInstanceRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error setting project: %s", err)
 	}
+	// The warning only belongs to the plan that found it.
+	if err := d.Set("preflight_quota_warning", ""); err != nil {
+		return fmt.Errorf("Error setting preflight_quota_warning: %s", err)
+	}
 	if err := d.Set("zone", zone); err != nil {
 		return fmt.Errorf("Error setting zone: %s", err)
 	}
@@ -1706,6 +1794,56 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if d.HasChange("boot_disk.0.initialize_params.0.size") {
+		source, err := ParseDiskFieldValue(d.Get("boot_disk.0.source").(string), d, config)
+		if err != nil {
+			return err
+		}
+
+		resizeReq := &compute.DisksResizeRequest{
+			SizeGb: int64(d.Get("boot_disk.0.initialize_params.0.size").(int)),
+		}
+		op, err := config.NewComputeClient(userAgent).Disks.Resize(source.Project, source.Zone, source.Name, resizeReq).Do()
+		if err != nil {
+			return fmt.Errorf("Error resizing boot disk: %s", err)
+		}
+
+		opErr := computeOperationWaitTime(config, op, project, "resizing boot disk", userAgent, d.Timeout(schema.TimeoutUpdate))
+		if opErr != nil {
+			return opErr
+		}
+		log.Printf("[DEBUG] Successfully resized boot disk %s", source.Name)
+	}
+
+	if d.HasChange("boot_disk.0.initialize_params.0.provisioned_iops") || d.HasChange("boot_disk.0.initialize_params.0.provisioned_throughput") {
+		source, err := ParseDiskFieldValue(d.Get("boot_disk.0.source").(string), d, config)
+		if err != nil {
+			return err
+		}
+
+		disk := &compute.Disk{}
+		var paths []string
+		if d.HasChange("boot_disk.0.initialize_params.0.provisioned_iops") {
+			disk.ProvisionedIops = int64(d.Get("boot_disk.0.initialize_params.0.provisioned_iops").(int))
+			paths = append(paths, "provisionedIops")
+		}
+		if d.HasChange("boot_disk.0.initialize_params.0.provisioned_throughput") {
+			disk.ProvisionedThroughput = int64(d.Get("boot_disk.0.initialize_params.0.provisioned_throughput").(int))
+			paths = append(paths, "provisionedThroughput")
+		}
+
+		op, err := config.NewComputeClient(userAgent).Disks.Update(source.Project, source.Zone, source.Name, disk).Paths(paths...).Do()
+		if err != nil {
+			return fmt.Errorf("Error updating boot disk: %s", err)
+		}
+
+		opErr := computeOperationWaitTime(config, op, project, "updating boot disk", userAgent, d.Timeout(schema.TimeoutUpdate))
+		if opErr != nil {
+			return opErr
+		}
+		log.Printf("[DEBUG] Successfully updated boot disk %s", source.Name)
+	}
+
 	if d.HasChange("attached_disk") {
 		o, n := d.GetChange("attached_disk")
 
@@ -2385,6 +2523,14 @@ func expandBootDisk(d *schema.ResourceData, config *Config, project string) (*co
 			disk.InitializeParams.DiskSizeGb = int64(v.(int))
 		}
 
+		if v, ok := d.GetOk("boot_disk.0.initialize_params.0.provisioned_iops"); ok {
+			disk.InitializeParams.ProvisionedIops = int64(v.(int))
+		}
+
+		if v, ok := d.GetOk("boot_disk.0.initialize_params.0.provisioned_throughput"); ok {
+			disk.InitializeParams.ProvisionedThroughput = int64(v.(int))
+		}
+
 		if v, ok := d.GetOk("boot_disk.0.initialize_params.0.type"); ok {
 			diskTypeName := v.(string)
 			diskType, err := readDiskType(config, d, diskTypeName)
@@ -2442,9 +2588,11 @@ func flattenBootDisk(d *schema.ResourceData, disk *compute.AttachedDisk, config
 			"type": GetResourceNameFromSelfLink(diskDetails.Type),
 			// If the config specifies a family name that doesn't match the image name, then
 			// the diff won't be properly suppressed. See DiffSuppressFunc for this field.
-			"image":  diskDetails.SourceImage,
-			"size":   diskDetails.SizeGb,
-			"labels": diskDetails.Labels,
+			"image":                  diskDetails.SourceImage,
+			"size":                   diskDetails.SizeGb,
+			"labels":                 diskDetails.Labels,
+			"provisioned_iops":       diskDetails.ProvisionedIops,
+			"provisioned_throughput": diskDetails.ProvisionedThroughput,
 		}}
 	}
 
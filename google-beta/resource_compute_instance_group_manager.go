@@ -27,6 +27,7 @@ func resourceComputeInstanceGroupManager() *schema.Resource {
 			Update: schema.DefaultTimeout(15 * time.Minute),
 			Delete: schema.DefaultTimeout(15 * time.Minute),
 		},
+		CustomizeDiff: computeInstanceGroupManagerQuotaPreflight(false),
 		Schema: map[string]*schema.Schema{
 			"base_instance_name": {
 				Type:        schema.TypeString,
@@ -142,6 +143,8 @@ func resourceComputeInstanceGroupManager() *schema.Resource {
 				Description: `The ID of the project in which the resource belongs. If it is not provided, the provider project is used.`,
 			},
 
+			"preflight_quota_warning": computeQuotaPreflightWarningSchema(),
+
 			"self_link": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -320,12 +323,76 @@ func resourceComputeInstanceGroupManager() *schema.Resource {
 							Type:         schema.TypeString,
 							Default:      "NEVER",
 							Optional:     true,
-							ValidateFunc: validation.StringInSlice([]string{"NEVER", "ON_PERMANENT_INSTANCE_DELETION"}, true),
+							ValidateFunc: validation.StringInSlice([]string{"NEVER", "ON_PERMANENT_INSTANCE_DELETION"}, false),
 							Description:  `A value that prescribes what should happen to the stateful disk when the VM instance is deleted. The available options are NEVER and ON_PERMANENT_INSTANCE_DELETION. NEVER - detach the disk when the VM is deleted, but do not delete the disk. ON_PERMANENT_INSTANCE_DELETION will delete the stateful disk when the VM is permanently deleted from the instance group. The default is NEVER.`,
 						},
 					},
 				},
 			},
+			"stateful_internal_ip": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: `Internal network IPs assigned to the instances that will be preserved on instance delete, update, etc. This map is keyed with the network interface name.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interface_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "nic0",
+							Description: `The network interface name of the internal IP.`,
+						},
+
+						"delete_rule": {
+							Type:         schema.TypeString,
+							Default:      "NEVER",
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"NEVER", "ON_PERMANENT_INSTANCE_DELETION"}, false),
+							Description:  `A value that prescribes what should happen to an associated static Address resource when a VM instance is permanently deleted. The available options are NEVER and ON_PERMANENT_INSTANCE_DELETION. NEVER - detach the IP when the VM is deleted, but do not delete the address resource. ON_PERMANENT_INSTANCE_DELETION will delete the stateful address when the VM is permanently deleted from the instance group. The default is NEVER.`,
+						},
+					},
+				},
+			},
+			"stateful_external_ip": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: `External network IPs assigned to the instances that will be preserved on instance delete, update, etc. This map is keyed with the network interface name.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interface_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "nic0",
+							Description: `The network interface name of the external IP.`,
+						},
+
+						"delete_rule": {
+							Type:         schema.TypeString,
+							Default:      "NEVER",
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"NEVER", "ON_PERMANENT_INSTANCE_DELETION"}, false),
+							Description:  `A value that prescribes what should happen to an associated static Address resource when a VM instance is permanently deleted. The available options are NEVER and ON_PERMANENT_INSTANCE_DELETION. NEVER - detach the IP when the VM is deleted, but do not delete the address resource. ON_PERMANENT_INSTANCE_DELETION will delete the stateful address when the VM is permanently deleted from the instance group. The default is NEVER.`,
+						},
+					},
+				},
+			},
+			"instance_lifecycle_policy": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				MaxItems:    1,
+				Description: `The instance lifecycle policy for this managed instance group.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"force_update_on_repair": {
+							Type:         schema.TypeString,
+							Default:      "NO",
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"YES", "NO"}, false),
+							Description:  `Specifies whether to apply the group's latest configuration when repairing a VM. Valid options are: YES, NO. If YES and you updated the group's instance template or per-instance configurations after the VM was created, then these changes are applied when VM is repaired. If NO (default), then updates are applied in accordance with the group's update policy type.`,
+						},
+					},
+				},
+			},
 			"operation": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -451,17 +518,18 @@ func resourceComputeInstanceGroupManagerCreate(d *schema.ResourceData, meta inte
 
 	// Build the parameter
 	manager := &compute.InstanceGroupManager{
-		Name:                d.Get("name").(string),
-		Description:         d.Get("description").(string),
-		BaseInstanceName:    d.Get("base_instance_name").(string),
-		TargetSize:          int64(d.Get("target_size").(int)),
-		NamedPorts:          getNamedPortsBeta(d.Get("named_port").(*schema.Set).List()),
-		TargetPools:         convertStringSet(d.Get("target_pools").(*schema.Set)),
-		AutoHealingPolicies: expandAutoHealingPolicies(d.Get("auto_healing_policies").([]interface{})),
-		Versions:            expandVersions(d.Get("version").([]interface{})),
-		UpdatePolicy:        expandUpdatePolicy(d.Get("update_policy").([]interface{})),
-		AllInstancesConfig:  expandAllInstancesConfig(nil, d.Get("all_instances_config").([]interface{})),
-		StatefulPolicy:      expandStatefulPolicy(d.Get("stateful_disk").(*schema.Set).List()),
+		Name:                    d.Get("name").(string),
+		Description:             d.Get("description").(string),
+		BaseInstanceName:        d.Get("base_instance_name").(string),
+		TargetSize:              int64(d.Get("target_size").(int)),
+		NamedPorts:              getNamedPortsBeta(d.Get("named_port").(*schema.Set).List()),
+		TargetPools:             convertStringSet(d.Get("target_pools").(*schema.Set)),
+		AutoHealingPolicies:     expandAutoHealingPolicies(d.Get("auto_healing_policies").([]interface{})),
+		Versions:                expandVersions(d.Get("version").([]interface{})),
+		UpdatePolicy:            expandUpdatePolicy(d.Get("update_policy").([]interface{})),
+		AllInstancesConfig:      expandAllInstancesConfig(nil, d.Get("all_instances_config").([]interface{})),
+		StatefulPolicy:          expandStatefulPolicy(d),
+		InstanceLifecyclePolicy: expandInstanceLifecyclePolicy(d.Get("instance_lifecycle_policy").([]interface{})),
 		// Force send TargetSize to allow a value of 0.
 		ForceSendFields: []string{"TargetSize"},
 	}
@@ -637,6 +705,10 @@ func resourceComputeInstanceGroupManagerRead(d *schema.ResourceData, meta interf
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error setting project: %s", err)
 	}
+	// The warning only belongs to the plan that found it.
+	if err := d.Set("preflight_quota_warning", ""); err != nil {
+		return fmt.Errorf("Error setting preflight_quota_warning: %s", err)
+	}
 	if err := d.Set("target_size", manager.TargetSize); err != nil {
 		return fmt.Errorf("Error setting target_size: %s", err)
 	}
@@ -649,6 +721,15 @@ func resourceComputeInstanceGroupManagerRead(d *schema.ResourceData, meta interf
 	if err = d.Set("stateful_disk", flattenStatefulPolicy(manager.StatefulPolicy)); err != nil {
 		return fmt.Errorf("Error setting stateful_disk in state: %s", err.Error())
 	}
+	if err = d.Set("stateful_internal_ip", flattenStatefulPolicyStatefulInternalIps(manager.StatefulPolicy)); err != nil {
+		return fmt.Errorf("Error setting stateful_internal_ip in state: %s", err.Error())
+	}
+	if err = d.Set("stateful_external_ip", flattenStatefulPolicyStatefulExternalIps(manager.StatefulPolicy)); err != nil {
+		return fmt.Errorf("Error setting stateful_external_ip in state: %s", err.Error())
+	}
+	if err = d.Set("instance_lifecycle_policy", flattenInstanceLifecyclePolicy(manager.InstanceLifecyclePolicy)); err != nil {
+		return fmt.Errorf("Error setting instance_lifecycle_policy in state: %s", err.Error())
+	}
 	if err := d.Set("fingerprint", manager.Fingerprint); err != nil {
 		return fmt.Errorf("Error setting fingerprint: %s", err)
 	}
@@ -748,8 +829,13 @@ func resourceComputeInstanceGroupManagerUpdate(d *schema.ResourceData, meta inte
 		change = true
 	}
 
-	if d.HasChange("stateful_disk") {
-		updatedManager.StatefulPolicy = expandStatefulPolicy(d.Get("stateful_disk").(*schema.Set).List())
+	if d.HasChange("stateful_disk") || d.HasChange("stateful_internal_ip") || d.HasChange("stateful_external_ip") {
+		updatedManager.StatefulPolicy = expandStatefulPolicy(d)
+		change = true
+	}
+
+	if d.HasChange("instance_lifecycle_policy") {
+		updatedManager.InstanceLifecyclePolicy = expandInstanceLifecyclePolicy(d.Get("instance_lifecycle_policy").([]interface{}))
 		change = true
 	}
 
@@ -917,19 +1003,78 @@ func expandAutoHealingPolicies(configured []interface{}) []*compute.InstanceGrou
 	return autoHealingPolicies
 }
 
-func expandStatefulPolicy(configured []interface{}) *compute.StatefulPolicy {
+func expandStatefulPolicy(d *schema.ResourceData) *compute.StatefulPolicy {
+	preservedState := &compute.StatefulPolicyPreservedState{}
+
 	disks := make(map[string]compute.StatefulPolicyPreservedStateDiskDevice)
-	for _, raw := range configured {
+	for _, raw := range d.Get("stateful_disk").(*schema.Set).List() {
 		data := raw.(map[string]interface{})
 		disk := compute.StatefulPolicyPreservedStateDiskDevice{
 			AutoDelete: data["delete_rule"].(string),
 		}
 		disks[data["device_name"].(string)] = disk
 	}
-	if len(disks) > 0 {
-		return &compute.StatefulPolicy{PreservedState: &compute.StatefulPolicyPreservedState{Disks: disks}}
+	preservedState.Disks = disks
+	// Entries removed from the config have to be nulled explicitly, PATCH merges the maps otherwise.
+	if d.HasChange("stateful_disk") {
+		oldDisks, _ := d.GetChange("stateful_disk")
+		for _, raw := range oldDisks.(*schema.Set).List() {
+			deviceName := raw.(map[string]interface{})["device_name"].(string)
+			if _, ok := disks[deviceName]; !ok {
+				preservedState.NullFields = append(preservedState.NullFields, "Disks."+deviceName)
+			}
+		}
 	}
-	return nil
+
+	internalIps := expandStatefulPolicyStatefulIps(d.Get("stateful_internal_ip").(*schema.Set).List())
+	preservedState.InternalIPs = internalIps
+	if d.HasChange("stateful_internal_ip") {
+		oldIps, _ := d.GetChange("stateful_internal_ip")
+		for _, raw := range oldIps.(*schema.Set).List() {
+			interfaceName := raw.(map[string]interface{})["interface_name"].(string)
+			if _, ok := internalIps[interfaceName]; !ok {
+				preservedState.NullFields = append(preservedState.NullFields, "InternalIPs."+interfaceName)
+			}
+		}
+	}
+
+	externalIps := expandStatefulPolicyStatefulIps(d.Get("stateful_external_ip").(*schema.Set).List())
+	preservedState.ExternalIPs = externalIps
+	if d.HasChange("stateful_external_ip") {
+		oldIps, _ := d.GetChange("stateful_external_ip")
+		for _, raw := range oldIps.(*schema.Set).List() {
+			interfaceName := raw.(map[string]interface{})["interface_name"].(string)
+			if _, ok := externalIps[interfaceName]; !ok {
+				preservedState.NullFields = append(preservedState.NullFields, "ExternalIPs."+interfaceName)
+			}
+		}
+	}
+
+	if len(disks) == 0 && len(internalIps) == 0 && len(externalIps) == 0 && len(preservedState.NullFields) == 0 {
+		return nil
+	}
+	return &compute.StatefulPolicy{PreservedState: preservedState}
+}
+
+func expandStatefulPolicyStatefulIps(configured []interface{}) map[string]compute.StatefulPolicyPreservedStateNetworkIp {
+	ips := make(map[string]compute.StatefulPolicyPreservedStateNetworkIp)
+	for _, raw := range configured {
+		data := raw.(map[string]interface{})
+		ips[data["interface_name"].(string)] = compute.StatefulPolicyPreservedStateNetworkIp{
+			AutoDelete: data["delete_rule"].(string),
+		}
+	}
+	return ips
+}
+
+func expandInstanceLifecyclePolicy(configured []interface{}) *compute.InstanceGroupManagerInstanceLifecyclePolicy {
+	instanceLifecyclePolicy := &compute.InstanceGroupManagerInstanceLifecyclePolicy{}
+
+	for _, raw := range configured {
+		data := raw.(map[string]interface{})
+		instanceLifecyclePolicy.ForceUpdateOnRepair = data["force_update_on_repair"].(string)
+	}
+	return instanceLifecyclePolicy
 }
 
 func expandVersions(configured []interface{}) []*compute.InstanceGroupManagerVersion {
@@ -1045,6 +1190,43 @@ func flattenStatefulPolicy(statefulPolicy *compute.StatefulPolicy) []map[string]
 	return result
 }
 
+func flattenStatefulPolicyStatefulInternalIps(statefulPolicy *compute.StatefulPolicy) []map[string]interface{} {
+	if statefulPolicy == nil || statefulPolicy.PreservedState == nil {
+		return make([]map[string]interface{}, 0, 0)
+	}
+	return flattenStatefulPolicyStatefulIps(statefulPolicy.PreservedState.InternalIPs)
+}
+
+func flattenStatefulPolicyStatefulExternalIps(statefulPolicy *compute.StatefulPolicy) []map[string]interface{} {
+	if statefulPolicy == nil || statefulPolicy.PreservedState == nil {
+		return make([]map[string]interface{}, 0, 0)
+	}
+	return flattenStatefulPolicyStatefulIps(statefulPolicy.PreservedState.ExternalIPs)
+}
+
+func flattenStatefulPolicyStatefulIps(ips map[string]compute.StatefulPolicyPreservedStateNetworkIp) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(ips))
+	for interfaceName, ip := range ips {
+		data := map[string]interface{}{
+			"interface_name": interfaceName,
+			"delete_rule":    ip.AutoDelete,
+		}
+
+		result = append(result, data)
+	}
+	return result
+}
+
+func flattenInstanceLifecyclePolicy(instanceLifecyclePolicy *compute.InstanceGroupManagerInstanceLifecyclePolicy) []map[string]interface{} {
+	results := []map[string]interface{}{}
+	if instanceLifecyclePolicy != nil {
+		ilp := map[string]interface{}{}
+		ilp["force_update_on_repair"] = instanceLifecyclePolicy.ForceUpdateOnRepair
+		results = append(results, ilp)
+	}
+	return results
+}
+
 func flattenUpdatePolicy(updatePolicy *compute.InstanceGroupManagerUpdatePolicy) []map[string]interface{} {
 	results := []map[string]interface{}{}
 	if updatePolicy != nil {
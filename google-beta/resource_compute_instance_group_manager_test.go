@@ -1379,6 +1379,7 @@ resource "google_compute_instance_template" "igm-basic" {
 
   network_interface {
     network = "default"
+    access_config {}
   }
 
   service_account {
@@ -1407,6 +1408,17 @@ resource "google_compute_instance_group_manager" "igm-basic" {
     device_name = "my-stateful-disk"
     delete_rule = "ON_PERMANENT_INSTANCE_DELETION"
   }
+  stateful_internal_ip {
+    interface_name = "nic0"
+    delete_rule    = "NEVER"
+  }
+  stateful_external_ip {
+    interface_name = "nic0"
+    delete_rule    = "NEVER"
+  }
+  instance_lifecycle_policy {
+    force_update_on_repair = "YES"
+  }
 }
 
 resource "google_compute_http_health_check" "zero" {
@@ -1451,6 +1463,7 @@ resource "google_compute_instance_template" "igm-basic" {
 
   network_interface {
     network = "default"
+    access_config {}
   }
 
   service_account {
@@ -1484,6 +1497,13 @@ resource "google_compute_instance_group_manager" "igm-basic" {
     device_name = "my-stateful-disk2"
     delete_rule = "ON_PERMANENT_INSTANCE_DELETION"
   }
+  stateful_internal_ip {
+    interface_name = "nic0"
+    delete_rule    = "ON_PERMANENT_INSTANCE_DELETION"
+  }
+  instance_lifecycle_policy {
+    force_update_on_repair = "NO"
+  }
 }
 
 resource "google_compute_http_health_check" "zero" {
@@ -702,6 +702,43 @@ func TestAccComputeInstance_bootDisk_type(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstance_bootDisk_resize(t *testing.T) {
+	t.Parallel()
+
+	var instance compute.Instance
+	var instanceName = fmt.Sprintf("tf-test-%s", randString(t, 10))
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_bootDisk_size(instanceName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(
+						t, "google_compute_instance.foobar", &instance),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", instanceName, []string{}),
+			{
+				Config: testAccComputeInstance_bootDisk_size(instanceName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceNotRecreated(
+						t, "google_compute_instance.foobar", &instance),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "boot_disk.0.initialize_params.0.size", "30"),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", instanceName, []string{}),
+			{
+				Config:      testAccComputeInstance_bootDisk_size(instanceName, 20),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("can't be decreased"),
+			},
+		},
+	})
+}
+
 func TestAccComputeInstance_bootDisk_mode(t *testing.T) {
 	t.Parallel()
 
@@ -2266,6 +2303,98 @@ func TestAccComputeInstance_spotVM_update(t *testing.T) {
 	})
 }
 
+func TestComputeInstance_bootDiskSizeCustomizedDiff(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Before, After map[string]interface{}
+		ExpectError   bool
+	}{
+		"no change": {
+			Before: map[string]interface{}{"boot_disk.0.initialize_params.0.size": 20},
+			After:  map[string]interface{}{"boot_disk.0.initialize_params.0.size": 20},
+		},
+		"create": {
+			Before: map[string]interface{}{"boot_disk.0.initialize_params.0.size": 0},
+			After:  map[string]interface{}{"boot_disk.0.initialize_params.0.size": 20},
+		},
+		"increase": {
+			Before: map[string]interface{}{"boot_disk.0.initialize_params.0.size": 20},
+			After:  map[string]interface{}{"boot_disk.0.initialize_params.0.size": 30},
+		},
+		"decrease": {
+			Before:      map[string]interface{}{"boot_disk.0.initialize_params.0.size": 30},
+			After:       map[string]interface{}{"boot_disk.0.initialize_params.0.size": 20},
+			ExpectError: true,
+		},
+		"decrease with new image": {
+			Before: map[string]interface{}{
+				"boot_disk.0.initialize_params.0.size":  30,
+				"boot_disk.0.initialize_params.0.image": "debian-10",
+			},
+			After: map[string]interface{}{
+				"boot_disk.0.initialize_params.0.size":  20,
+				"boot_disk.0.initialize_params.0.image": "debian-11",
+			},
+		},
+		"decrease with new name": {
+			Before: map[string]interface{}{
+				"boot_disk.0.initialize_params.0.size": 30,
+				"name":                                 "foo",
+			},
+			After: map[string]interface{}{
+				"boot_disk.0.initialize_params.0.size": 20,
+				"name":                                 "bar",
+			},
+		},
+		"decrease with new zone": {
+			Before: map[string]interface{}{
+				"boot_disk.0.initialize_params.0.size": 30,
+				"zone":                                 "us-central1-a",
+			},
+			After: map[string]interface{}{
+				"boot_disk.0.initialize_params.0.size": 20,
+				"zone":                                 "us-central1-b",
+			},
+		},
+		"decrease with new auto_delete": {
+			Before: map[string]interface{}{
+				"boot_disk.0.initialize_params.0.size": 30,
+				"boot_disk.0.auto_delete":              true,
+			},
+			After: map[string]interface{}{
+				"boot_disk.0.initialize_params.0.size": 20,
+				"boot_disk.0.auto_delete":              false,
+			},
+		},
+		"decrease with in-place change": {
+			Before: map[string]interface{}{
+				"boot_disk.0.initialize_params.0.size": 30,
+				"machine_type":                         "e2-medium",
+			},
+			After: map[string]interface{}{
+				"boot_disk.0.initialize_params.0.size": 20,
+				"machine_type":                         "e2-standard-2",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			Before: tc.Before,
+			After:  tc.After,
+		}
+		err := rejectBootDiskSizeDecreaseFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
 func TestComputeInstance_networkIPCustomizedDiff(t *testing.T) {
 	t.Parallel()
 
@@ -2670,6 +2799,19 @@ func testAccCheckComputeInstanceBootDiskType(t *testing.T, instanceName string,
 	}
 }
 
+func testAccCheckComputeInstanceNotRecreated(t *testing.T, n string, instance *compute.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var current compute.Instance
+		if err := testAccCheckComputeInstanceExists(t, n, &current)(s); err != nil {
+			return err
+		}
+		if current.Id != instance.Id {
+			return fmt.Errorf("Instance was recreated: id changed from %d to %d", instance.Id, current.Id)
+		}
+		return nil
+	}
+}
+
 func testAccCheckComputeInstanceScratchDisk(instance *compute.Instance, interfaces []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if instance.Disks == nil {
@@ -4372,6 +4514,32 @@ resource "google_compute_instance" "foobar" {
 `, instance, diskType)
 }
 
+func testAccComputeInstance_bootDisk_size(instance string, size int) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+  family  = "debian-11"
+  project = "debian-cloud"
+}
+
+resource "google_compute_instance" "foobar" {
+  name         = "%s"
+  machine_type = "e2-medium"
+  zone         = "us-central1-a"
+
+  boot_disk {
+    initialize_params {
+      image = data.google_compute_image.my_image.self_link
+      size  = %d
+    }
+  }
+
+  network_interface {
+    network = "default"
+  }
+}
+`, instance, size)
+}
+
 func testAccComputeInstance_bootDisk_mode(instance string, diskMode string) string {
 	return fmt.Sprintf(`
 data "google_compute_image" "my_image" {
@@ -0,0 +1,281 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	compute "google.golang.org/api/compute/v0.beta"
+)
+
+func resourceComputeNetworkFirewallPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeNetworkFirewallPolicyCreate(false),
+		Read:   resourceComputeNetworkFirewallPolicyRead(false),
+		Update: resourceComputeNetworkFirewallPolicyUpdate(false),
+		Delete: resourceComputeNetworkFirewallPolicyDelete(false),
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeNetworkFirewallPolicyImport(false),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema:        computeNetworkFirewallPolicySchema(),
+		UseJSONNumber: true,
+	}
+}
+
+func computeNetworkFirewallPolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateGCEName,
+			Description:  `User-provided name of the network firewall policy. The name should be unique in the project in which the policy is created.`,
+		},
+
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: `An optional description of this resource.`,
+		},
+
+		"project": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: `The project for the resource. If it is not provided, the provider project is used.`,
+		},
+
+		"network_firewall_policy_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `The unique identifier for the resource. This identifier is defined by the server.`,
+		},
+
+		"creation_timestamp": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Creation timestamp in RFC3339 text format.`,
+		},
+
+		"fingerprint": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Fingerprint of the resource. This field is used internally during updates of this resource.`,
+		},
+
+		"rule_tuple_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: `Total count of all firewall policy rule tuples. A firewall policy can not exceed a set number of tuples.`,
+		},
+
+		"self_link": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Server-defined URL for the resource.`,
+		},
+
+		"self_link_with_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Server-defined URL for this resource with the resource id.`,
+		},
+	}
+}
+
+func resourceComputeNetworkFirewallPolicyCreate(regional bool) schema.CreateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*Config)
+		userAgent, err := generateUserAgentString(d, config.userAgent)
+		if err != nil {
+			return err
+		}
+
+		ref, err := getNetworkFirewallPolicyRef(d, config, "name", regional)
+		if err != nil {
+			return err
+		}
+
+		policy := &compute.FirewallPolicy{
+			Name:        ref.name,
+			Description: d.Get("description").(string),
+		}
+
+		log.Printf("[DEBUG] Creating network firewall policy %q: %#v", ref.id(), policy)
+
+		op, err := ref.insert(config.NewComputeClient(userAgent), policy)
+		if err != nil {
+			return fmt.Errorf("Error creating network firewall policy %q: %s", ref.id(), err)
+		}
+
+		d.SetId(ref.id())
+
+		err = computeOperationWaitTime(config, op, ref.project, fmt.Sprintf("Creating network firewall policy %q", ref.id()), userAgent, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			// The resource didn't actually create
+			d.SetId("")
+			return err
+		}
+
+		return resourceComputeNetworkFirewallPolicyRead(regional)(d, meta)
+	}
+}
+
+func resourceComputeNetworkFirewallPolicyRead(regional bool) schema.ReadFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*Config)
+		userAgent, err := generateUserAgentString(d, config.userAgent)
+		if err != nil {
+			return err
+		}
+
+		ref, err := getNetworkFirewallPolicyRef(d, config, "name", regional)
+		if err != nil {
+			return err
+		}
+
+		policy, err := ref.get(config.NewComputeClient(userAgent))
+		if err != nil {
+			return handleNotFoundError(err, d, fmt.Sprintf("Network firewall policy %q", d.Id()))
+		}
+
+		if err := d.Set("name", policy.Name); err != nil {
+			return fmt.Errorf("Error setting name: %s", err)
+		}
+		if err := d.Set("description", policy.Description); err != nil {
+			return fmt.Errorf("Error setting description: %s", err)
+		}
+		if err := d.Set("project", ref.project); err != nil {
+			return fmt.Errorf("Error setting project: %s", err)
+		}
+		if regional {
+			if err := d.Set("region", ref.region); err != nil {
+				return fmt.Errorf("Error setting region: %s", err)
+			}
+		}
+		if err := d.Set("network_firewall_policy_id", fmt.Sprintf("%d", policy.Id)); err != nil {
+			return fmt.Errorf("Error setting network_firewall_policy_id: %s", err)
+		}
+		if err := d.Set("creation_timestamp", policy.CreationTimestamp); err != nil {
+			return fmt.Errorf("Error setting creation_timestamp: %s", err)
+		}
+		if err := d.Set("fingerprint", policy.Fingerprint); err != nil {
+			return fmt.Errorf("Error setting fingerprint: %s", err)
+		}
+		if err := d.Set("rule_tuple_count", policy.RuleTupleCount); err != nil {
+			return fmt.Errorf("Error setting rule_tuple_count: %s", err)
+		}
+		if err := d.Set("self_link", ConvertSelfLinkToV1(policy.SelfLink)); err != nil {
+			return fmt.Errorf("Error setting self_link: %s", err)
+		}
+		if err := d.Set("self_link_with_id", ConvertSelfLinkToV1(policy.SelfLinkWithId)); err != nil {
+			return fmt.Errorf("Error setting self_link_with_id: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func resourceComputeNetworkFirewallPolicyUpdate(regional bool) schema.UpdateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*Config)
+		userAgent, err := generateUserAgentString(d, config.userAgent)
+		if err != nil {
+			return err
+		}
+
+		ref, err := getNetworkFirewallPolicyRef(d, config, "name", regional)
+		if err != nil {
+			return err
+		}
+
+		if d.HasChange("description") {
+			activity := fmt.Sprintf("updating network firewall policy %q", ref.id())
+			err := ref.modify(config, userAgent, activity, d.Timeout(schema.TimeoutUpdate), func(client *compute.Service) (*compute.Operation, error) {
+				// Rules and associations change the fingerprint of the policy, so the one in
+				// the state may be stale. The lock ensures nothing changes it until the patch.
+				current, err := ref.get(client)
+				if err != nil {
+					return nil, err
+				}
+
+				policy := &compute.FirewallPolicy{
+					Description:     d.Get("description").(string),
+					Fingerprint:     current.Fingerprint,
+					ForceSendFields: []string{"Description"},
+				}
+				return ref.patch(client, policy)
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return resourceComputeNetworkFirewallPolicyRead(regional)(d, meta)
+	}
+}
+
+func resourceComputeNetworkFirewallPolicyDelete(regional bool) schema.DeleteFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*Config)
+		userAgent, err := generateUserAgentString(d, config.userAgent)
+		if err != nil {
+			return err
+		}
+
+		ref, err := getNetworkFirewallPolicyRef(d, config, "name", regional)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Deleting network firewall policy %q", ref.id())
+
+		err = ref.modify(config, userAgent, fmt.Sprintf("deleting network firewall policy %q", ref.id()), d.Timeout(schema.TimeoutDelete), ref.delete)
+		if err != nil {
+			return err
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
+
+func resourceComputeNetworkFirewallPolicyImport(regional bool) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		config := meta.(*Config)
+
+		idRegexes := []string{
+			"projects/(?P<project>[^/]+)/global/firewallPolicies/(?P<name>[^/]+)",
+			"(?P<project>[^/]+)/(?P<name>[^/]+)",
+			"(?P<name>[^/]+)",
+		}
+		if regional {
+			idRegexes = []string{
+				"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/firewallPolicies/(?P<name>[^/]+)",
+				"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)",
+				"(?P<region>[^/]+)/(?P<name>[^/]+)",
+				"(?P<name>[^/]+)",
+			}
+		}
+		if err := parseImportId(idRegexes, d, config); err != nil {
+			return nil, err
+		}
+
+		ref, err := getNetworkFirewallPolicyRef(d, config, "name", regional)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref.id())
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
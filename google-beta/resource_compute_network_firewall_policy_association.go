@@ -0,0 +1,213 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	compute "google.golang.org/api/compute/v0.beta"
+)
+
+func resourceComputeNetworkFirewallPolicyAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeNetworkFirewallPolicyAssociationCreate(false),
+		Read:   resourceComputeNetworkFirewallPolicyAssociationRead(false),
+		Delete: resourceComputeNetworkFirewallPolicyAssociationDelete(false),
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeNetworkFirewallPolicyAssociationImport(false),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema:        computeNetworkFirewallPolicyAssociationSchema(),
+		UseJSONNumber: true,
+	}
+}
+
+func computeNetworkFirewallPolicyAssociationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: `The name for an association.`,
+		},
+
+		"attachment_target": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: compareSelfLinkOrResourceName,
+			Description:      `The target that the firewall policy is attached to, the self link of a VPC network.`,
+		},
+
+		"firewall_policy": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: compareSelfLinkOrResourceName,
+			Description:      `The firewall policy ID of the association.`,
+		},
+
+		"project": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: `The project for the resource. If it is not provided, the provider project is used.`,
+		},
+
+		"short_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `The short name of the firewall policy of the association.`,
+		},
+	}
+}
+
+func resourceComputeNetworkFirewallPolicyAssociationCreate(regional bool) schema.CreateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*Config)
+		userAgent, err := generateUserAgentString(d, config.userAgent)
+		if err != nil {
+			return err
+		}
+
+		ref, err := getNetworkFirewallPolicyRef(d, config, "firewall_policy", regional)
+		if err != nil {
+			return err
+		}
+
+		association := &compute.FirewallPolicyAssociation{
+			Name:             d.Get("name").(string),
+			AttachmentTarget: d.Get("attachment_target").(string),
+		}
+		log.Printf("[DEBUG] Associating network firewall policy %q: %#v", ref.id(), association)
+
+		activity := fmt.Sprintf("associating network firewall policy %q with %q", ref.id(), association.AttachmentTarget)
+		err = ref.modify(config, userAgent, activity, d.Timeout(schema.TimeoutCreate), func(client *compute.Service) (*compute.Operation, error) {
+			return ref.addAssociation(client, association)
+		})
+		if err != nil {
+			return err
+		}
+
+		d.SetId(fmt.Sprintf("%s/associations/%s", ref.id(), association.Name))
+
+		return resourceComputeNetworkFirewallPolicyAssociationRead(regional)(d, meta)
+	}
+}
+
+func resourceComputeNetworkFirewallPolicyAssociationRead(regional bool) schema.ReadFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*Config)
+		userAgent, err := generateUserAgentString(d, config.userAgent)
+		if err != nil {
+			return err
+		}
+
+		ref, err := getNetworkFirewallPolicyRef(d, config, "firewall_policy", regional)
+		if err != nil {
+			return err
+		}
+
+		policy, err := ref.get(config.NewComputeClient(userAgent))
+		if err != nil {
+			return handleNotFoundError(err, d, fmt.Sprintf("Network firewall policy association %q", d.Id()))
+		}
+
+		var association *compute.FirewallPolicyAssociation
+		for _, a := range policy.Associations {
+			if a.Name == d.Get("name").(string) {
+				association = a
+				break
+			}
+		}
+		if association == nil {
+			log.Printf("[WARN] Removing network firewall policy association %q because it's gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		if err := d.Set("project", ref.project); err != nil {
+			return fmt.Errorf("Error setting project: %s", err)
+		}
+		if regional {
+			if err := d.Set("region", ref.region); err != nil {
+				return fmt.Errorf("Error setting region: %s", err)
+			}
+		}
+		if err := d.Set("attachment_target", ConvertSelfLinkToV1(association.AttachmentTarget)); err != nil {
+			return fmt.Errorf("Error setting attachment_target: %s", err)
+		}
+		if err := d.Set("short_name", association.ShortName); err != nil {
+			return fmt.Errorf("Error setting short_name: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func resourceComputeNetworkFirewallPolicyAssociationDelete(regional bool) schema.DeleteFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*Config)
+		userAgent, err := generateUserAgentString(d, config.userAgent)
+		if err != nil {
+			return err
+		}
+
+		ref, err := getNetworkFirewallPolicyRef(d, config, "firewall_policy", regional)
+		if err != nil {
+			return err
+		}
+
+		name := d.Get("name").(string)
+		log.Printf("[DEBUG] Removing association %q from network firewall policy %q", name, ref.id())
+
+		activity := fmt.Sprintf("removing association %q from network firewall policy %q", name, ref.id())
+		err = ref.modify(config, userAgent, activity, d.Timeout(schema.TimeoutDelete), func(client *compute.Service) (*compute.Operation, error) {
+			return ref.removeAssociation(client, name)
+		})
+		if err != nil {
+			return err
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
+
+func resourceComputeNetworkFirewallPolicyAssociationImport(regional bool) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		config := meta.(*Config)
+
+		idRegexes := []string{
+			"projects/(?P<project>[^/]+)/global/firewallPolicies/(?P<firewall_policy>[^/]+)/associations/(?P<name>[^/]+)",
+			"(?P<project>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<name>[^/]+)",
+			"(?P<firewall_policy>[^/]+)/(?P<name>[^/]+)",
+		}
+		if regional {
+			idRegexes = []string{
+				"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/firewallPolicies/(?P<firewall_policy>[^/]+)/associations/(?P<name>[^/]+)",
+				"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<name>[^/]+)",
+				"(?P<region>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<name>[^/]+)",
+				"(?P<firewall_policy>[^/]+)/(?P<name>[^/]+)",
+			}
+		}
+		if err := parseImportId(idRegexes, d, config); err != nil {
+			return nil, err
+		}
+
+		ref, err := getNetworkFirewallPolicyRef(d, config, "firewall_policy", regional)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(fmt.Sprintf("%s/associations/%s", ref.id(), d.Get("name").(string)))
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
@@ -0,0 +1,332 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	compute "google.golang.org/api/compute/v0.beta"
+)
+
+func resourceComputeNetworkFirewallPolicyRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeNetworkFirewallPolicyRuleCreate(false),
+		Read:   resourceComputeNetworkFirewallPolicyRuleRead(false),
+		Update: resourceComputeNetworkFirewallPolicyRuleUpdate(false),
+		Delete: resourceComputeNetworkFirewallPolicyRuleDelete(false),
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeNetworkFirewallPolicyRuleImport(false),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema:        computeNetworkFirewallPolicyRuleSchema(),
+		UseJSONNumber: true,
+	}
+}
+
+func computeNetworkFirewallPolicyRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"firewall_policy": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: compareSelfLinkOrResourceName,
+			Description:      `The firewall policy of the resource.`,
+		},
+
+		"priority": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(0, 2147483647),
+			Description:  `An integer indicating the priority of a rule in the list. The priority must be a positive value between 0 and 2147483647. Rules are evaluated from highest to lowest priority where 0 is the highest priority and 2147483647 is the lowest prority.`,
+		},
+
+		"action": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"allow", "deny", "goto_next"}, false),
+			Description:  `The Action to perform when the client connection triggers the rule. Can currently be either "allow", "deny" or "goto_next".`,
+		},
+
+		"direction": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"INGRESS", "EGRESS"}, false),
+			Description:  `The direction in which this rule applies. Possible values: INGRESS, EGRESS`,
+		},
+
+		"match": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: `A match condition that incoming traffic is evaluated against. If it evaluates to true, the corresponding 'action' is enforced.`,
+			Elem:        computeNetworkFirewallPolicyRuleMatchSchema(),
+		},
+
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: `An optional description for this resource.`,
+		},
+
+		"rule_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: `An optional name for the rule. This field is not a unique identifier and can be updated.`,
+		},
+
+		"disabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: `Denotes whether the firewall policy rule is disabled. When set to true, the firewall policy rule is not enforced and traffic behaves as if it did not exist. If this is unspecified, the firewall policy rule will be enabled.`,
+		},
+
+		"enable_logging": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: `Denotes whether to enable logging for a particular rule. If logging is enabled, logs will be exported to the configured export destination in Stackdriver. Logs may be exported to BigQuery or Pub/Sub. Note: you cannot enable logging on "goto_next" rules.`,
+		},
+
+		"target_secure_tags": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: `A list of secure tags that controls which instances the firewall rule applies to. If target_secure_tags are specified, then the firewall rule applies only to instances in the VPC network that have one of those EFFECTIVE secure tags, if all the target_secure_tags are in INEFFECTIVE state, then this rule will be ignored. target_secure_tags may not be set at the same time as target_service_accounts.`,
+			Elem:        computeNetworkFirewallPolicyRuleSecureTagSchema(),
+		},
+
+		"target_service_accounts": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{"target_secure_tags"},
+			Description:   `A list of service accounts indicating the sets of instances that are applied with this rule.`,
+			Elem:          &schema.Schema{Type: schema.TypeString},
+		},
+
+		"project": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: `The project for the resource. If it is not provided, the provider project is used.`,
+		},
+
+		"kind": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Type of the resource. Always compute#firewallPolicyRule for firewall policy rules`,
+		},
+
+		"rule_tuple_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: `Calculation of the complexity of a single firewall policy rule.`,
+		},
+	}
+}
+
+func resourceComputeNetworkFirewallPolicyRuleCreate(regional bool) schema.CreateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*Config)
+		userAgent, err := generateUserAgentString(d, config.userAgent)
+		if err != nil {
+			return err
+		}
+
+		ref, err := getNetworkFirewallPolicyRef(d, config, "firewall_policy", regional)
+		if err != nil {
+			return err
+		}
+
+		rule := expandComputeNetworkFirewallPolicyRule(d)
+		log.Printf("[DEBUG] Adding rule %d to network firewall policy %q: %#v", rule.Priority, ref.id(), rule)
+
+		activity := fmt.Sprintf("adding rule %d to network firewall policy %q", rule.Priority, ref.id())
+		err = ref.modify(config, userAgent, activity, d.Timeout(schema.TimeoutCreate), func(client *compute.Service) (*compute.Operation, error) {
+			return ref.addRule(client, rule)
+		})
+		if err != nil {
+			return err
+		}
+
+		d.SetId(fmt.Sprintf("%s/rules/%d", ref.id(), rule.Priority))
+
+		return resourceComputeNetworkFirewallPolicyRuleRead(regional)(d, meta)
+	}
+}
+
+func resourceComputeNetworkFirewallPolicyRuleRead(regional bool) schema.ReadFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*Config)
+		userAgent, err := generateUserAgentString(d, config.userAgent)
+		if err != nil {
+			return err
+		}
+
+		ref, err := getNetworkFirewallPolicyRef(d, config, "firewall_policy", regional)
+		if err != nil {
+			return err
+		}
+
+		policy, err := ref.get(config.NewComputeClient(userAgent))
+		if err != nil {
+			return handleNotFoundError(err, d, fmt.Sprintf("Network firewall policy rule %q", d.Id()))
+		}
+
+		priority := int64(d.Get("priority").(int))
+		var rule *compute.FirewallPolicyRule
+		for _, r := range policy.Rules {
+			if r.Priority == priority {
+				rule = r
+				break
+			}
+		}
+		if rule == nil {
+			log.Printf("[WARN] Removing network firewall policy rule %q because it's gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		if err := d.Set("project", ref.project); err != nil {
+			return fmt.Errorf("Error setting project: %s", err)
+		}
+		if regional {
+			if err := d.Set("region", ref.region); err != nil {
+				return fmt.Errorf("Error setting region: %s", err)
+			}
+		}
+		if err := d.Set("priority", rule.Priority); err != nil {
+			return fmt.Errorf("Error setting priority: %s", err)
+		}
+		if err := d.Set("action", rule.Action); err != nil {
+			return fmt.Errorf("Error setting action: %s", err)
+		}
+		if err := d.Set("direction", rule.Direction); err != nil {
+			return fmt.Errorf("Error setting direction: %s", err)
+		}
+		if err := d.Set("match", flattenComputeNetworkFirewallPolicyRuleMatch(rule.Match)); err != nil {
+			return fmt.Errorf("Error setting match: %s", err)
+		}
+		if err := d.Set("description", rule.Description); err != nil {
+			return fmt.Errorf("Error setting description: %s", err)
+		}
+		if err := d.Set("rule_name", rule.RuleName); err != nil {
+			return fmt.Errorf("Error setting rule_name: %s", err)
+		}
+		if err := d.Set("disabled", rule.Disabled); err != nil {
+			return fmt.Errorf("Error setting disabled: %s", err)
+		}
+		if err := d.Set("enable_logging", rule.EnableLogging); err != nil {
+			return fmt.Errorf("Error setting enable_logging: %s", err)
+		}
+		if err := d.Set("target_secure_tags", flattenComputeNetworkFirewallPolicyRuleSecureTags(rule.TargetSecureTags)); err != nil {
+			return fmt.Errorf("Error setting target_secure_tags: %s", err)
+		}
+		if err := d.Set("target_service_accounts", rule.TargetServiceAccounts); err != nil {
+			return fmt.Errorf("Error setting target_service_accounts: %s", err)
+		}
+		if err := d.Set("kind", rule.Kind); err != nil {
+			return fmt.Errorf("Error setting kind: %s", err)
+		}
+		if err := d.Set("rule_tuple_count", rule.RuleTupleCount); err != nil {
+			return fmt.Errorf("Error setting rule_tuple_count: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func resourceComputeNetworkFirewallPolicyRuleUpdate(regional bool) schema.UpdateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*Config)
+		userAgent, err := generateUserAgentString(d, config.userAgent)
+		if err != nil {
+			return err
+		}
+
+		ref, err := getNetworkFirewallPolicyRef(d, config, "firewall_policy", regional)
+		if err != nil {
+			return err
+		}
+
+		rule := expandComputeNetworkFirewallPolicyRule(d)
+		log.Printf("[DEBUG] Updating rule %d of network firewall policy %q: %#v", rule.Priority, ref.id(), rule)
+
+		activity := fmt.Sprintf("updating rule %d of network firewall policy %q", rule.Priority, ref.id())
+		err = ref.modify(config, userAgent, activity, d.Timeout(schema.TimeoutUpdate), func(client *compute.Service) (*compute.Operation, error) {
+			return ref.patchRule(client, rule.Priority, rule)
+		})
+		if err != nil {
+			return err
+		}
+
+		return resourceComputeNetworkFirewallPolicyRuleRead(regional)(d, meta)
+	}
+}
+
+func resourceComputeNetworkFirewallPolicyRuleDelete(regional bool) schema.DeleteFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*Config)
+		userAgent, err := generateUserAgentString(d, config.userAgent)
+		if err != nil {
+			return err
+		}
+
+		ref, err := getNetworkFirewallPolicyRef(d, config, "firewall_policy", regional)
+		if err != nil {
+			return err
+		}
+
+		priority := int64(d.Get("priority").(int))
+		log.Printf("[DEBUG] Removing rule %d from network firewall policy %q", priority, ref.id())
+
+		activity := fmt.Sprintf("removing rule %d from network firewall policy %q", priority, ref.id())
+		err = ref.modify(config, userAgent, activity, d.Timeout(schema.TimeoutDelete), func(client *compute.Service) (*compute.Operation, error) {
+			return ref.removeRule(client, priority)
+		})
+		if err != nil {
+			return err
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
+
+func resourceComputeNetworkFirewallPolicyRuleImport(regional bool) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		config := meta.(*Config)
+
+		idRegexes := []string{
+			"projects/(?P<project>[^/]+)/global/firewallPolicies/(?P<firewall_policy>[^/]+)/rules/(?P<priority>[^/]+)",
+			"(?P<project>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<priority>[^/]+)",
+			"(?P<firewall_policy>[^/]+)/(?P<priority>[^/]+)",
+		}
+		if regional {
+			idRegexes = []string{
+				"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/firewallPolicies/(?P<firewall_policy>[^/]+)/rules/(?P<priority>[^/]+)",
+				"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<priority>[^/]+)",
+				"(?P<region>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<priority>[^/]+)",
+				"(?P<firewall_policy>[^/]+)/(?P<priority>[^/]+)",
+			}
+		}
+		if err := parseImportId(idRegexes, d, config); err != nil {
+			return nil, err
+		}
+
+		ref, err := getNetworkFirewallPolicyRef(d, config, "firewall_policy", regional)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(fmt.Sprintf("%s/rules/%d", ref.id(), d.Get("priority").(int)))
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
@@ -0,0 +1,234 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccComputeNetworkFirewallPolicy_update(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNetworkFirewallPolicyDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeNetworkFirewallPolicy_basic(context),
+			},
+			{
+				ResourceName:      "google_compute_network_firewall_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeNetworkFirewallPolicy_rules(context),
+			},
+			{
+				ResourceName:      "google_compute_network_firewall_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_compute_network_firewall_policy_rule.ingress",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_compute_network_firewall_policy_rule.egress",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_compute_network_firewall_policy_association.association",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeNetworkFirewallPolicy_rulesUpdate(context),
+			},
+			{
+				ResourceName:      "google_compute_network_firewall_policy_rule.ingress",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_compute_network_firewall_policy_rule.egress",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeNetworkFirewallPolicyDestroyProducer(t *testing.T) func(s *terraform.State) error {
+	return func(s *terraform.State) error {
+		config := googleProviderConfig(t)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "google_compute_network_firewall_policy" && rs.Type != "google_compute_region_network_firewall_policy" {
+				continue
+			}
+
+			ref := networkFirewallPolicyRef{
+				project: rs.Primary.Attributes["project"],
+				region:  rs.Primary.Attributes["region"],
+				name:    rs.Primary.Attributes["name"],
+			}
+			if _, err := ref.get(config.NewComputeClient(config.userAgent)); err == nil {
+				return fmt.Errorf("Network firewall policy %q still exists", ref.id())
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccComputeNetworkFirewallPolicy_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network_firewall_policy" "policy" {
+  name        = "tf-test-policy-%{random_suffix}"
+  description = "Sample global network firewall policy"
+}
+`, context)
+}
+
+func testAccComputeNetworkFirewallPolicy_rules(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "network" {
+  name                    = "tf-test-network-%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_service_account" "sa" {
+  account_id = "tf-test-sa-%{random_suffix}"
+}
+
+resource "google_compute_network_firewall_policy" "policy" {
+  name        = "tf-test-policy-%{random_suffix}"
+  description = "Updated global network firewall policy"
+}
+
+resource "google_compute_network_firewall_policy_association" "association" {
+  name              = "tf-test-association-%{random_suffix}"
+  attachment_target = google_compute_network.network.id
+  firewall_policy   = google_compute_network_firewall_policy.policy.name
+}
+
+resource "google_compute_network_firewall_policy_rule" "ingress" {
+  firewall_policy = google_compute_network_firewall_policy.policy.name
+  priority        = 1000
+  action          = "deny"
+  direction       = "INGRESS"
+  description     = "Block traffic from some countries"
+  rule_name       = "geo-block"
+  enable_logging  = true
+
+  match {
+    src_ip_ranges    = ["0.0.0.0/0"]
+    src_region_codes = ["AQ", "BV"]
+
+    layer4_configs {
+      ip_protocol = "tcp"
+      ports       = ["80", "443"]
+    }
+  }
+
+  target_service_accounts = [google_service_account.sa.email]
+}
+
+resource "google_compute_network_firewall_policy_rule" "egress" {
+  firewall_policy = google_compute_network_firewall_policy.policy.id
+  priority        = 2000
+  action          = "allow"
+  direction       = "EGRESS"
+
+  match {
+    dest_fqdns = ["example.com"]
+
+    layer4_configs {
+      ip_protocol = "tcp"
+      ports       = ["443"]
+    }
+  }
+}
+`, context)
+}
+
+func testAccComputeNetworkFirewallPolicy_rulesUpdate(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "network" {
+  name                    = "tf-test-network-%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_service_account" "sa" {
+  account_id = "tf-test-sa-%{random_suffix}"
+}
+
+resource "google_compute_network_firewall_policy" "policy" {
+  name        = "tf-test-policy-%{random_suffix}"
+  description = "Updated global network firewall policy"
+}
+
+resource "google_network_security_address_group" "partners" {
+  name     = "tf-test-partners-%{random_suffix}"
+  parent   = "projects/%{project}"
+  location = "global"
+  type     = "IPV4"
+  capacity = 100
+  items    = ["208.80.154.224/32"]
+}
+
+resource "google_compute_network_firewall_policy_association" "association" {
+  name              = "tf-test-association-%{random_suffix}"
+  attachment_target = google_compute_network.network.id
+  firewall_policy   = google_compute_network_firewall_policy.policy.name
+}
+
+resource "google_compute_network_firewall_policy_rule" "ingress" {
+  firewall_policy = google_compute_network_firewall_policy.policy.name
+  priority        = 1000
+  action          = "deny"
+  direction       = "INGRESS"
+  disabled        = true
+
+  match {
+    src_ip_ranges            = ["10.100.0.1/32"]
+    src_address_groups       = [google_network_security_address_group.partners.id]
+    src_region_codes         = ["AQ"]
+    src_threat_intelligences = ["iplist-known-malicious-ips"]
+
+    layer4_configs {
+      ip_protocol = "all"
+    }
+  }
+}
+
+resource "google_compute_network_firewall_policy_rule" "egress" {
+  firewall_policy = google_compute_network_firewall_policy.policy.id
+  priority        = 2000
+  action          = "deny"
+  direction       = "EGRESS"
+
+  match {
+    dest_fqdns                = ["example.com", "example.org"]
+    dest_address_groups       = [google_network_security_address_group.partners.id]
+    dest_region_codes         = ["AQ"]
+    dest_threat_intelligences = ["iplist-known-malicious-ips"]
+
+    layer4_configs {
+      ip_protocol = "tcp"
+    }
+  }
+}
+`, context)
+}
@@ -68,6 +68,20 @@ func resourceComputePerInstanceConfig() *schema.Resource {
 							Elem:        computePerInstanceConfigPreservedStateDiskSchema(),
 							// Default schema.HashSchema is used.
 						},
+						"external_ip": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: `Preserved external IPs defined for this instance. This map is keyed with the name of the network interface.`,
+							Elem:        computePreservedStateNetworkIpSchema(),
+							// Default schema.HashSchema is used.
+						},
+						"internal_ip": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: `Preserved internal IPs defined for this instance. This map is keyed with the name of the network interface.`,
+							Elem:        computePreservedStateNetworkIpSchema(),
+							// Default schema.HashSchema is used.
+						},
 						"metadata": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -543,6 +557,10 @@ func flattenNestedComputePerInstanceConfigPreservedState(v interface{}, d *schem
 		flattenNestedComputePerInstanceConfigPreservedStateMetadata(original["metadata"], d, config)
 	transformed["disk"] =
 		flattenNestedComputePerInstanceConfigPreservedStateDisk(original["disks"], d, config)
+	transformed["internal_ip"] =
+		flattenNestedComputePerInstanceConfigPreservedStateInternalIp(original["internalIPs"], d, config)
+	transformed["external_ip"] =
+		flattenNestedComputePerInstanceConfigPreservedStateExternalIp(original["externalIPs"], d, config)
 	return []interface{}{transformed}
 }
 func flattenNestedComputePerInstanceConfigPreservedStateMetadata(v interface{}, d *schema.ResourceData, config *Config) interface{} {
@@ -571,6 +589,14 @@ func flattenNestedComputePerInstanceConfigPreservedStateDisk(v interface{}, d *s
 	return transformed
 }
 
+func flattenNestedComputePerInstanceConfigPreservedStateInternalIp(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	return flattenComputePreservedStateNetworkIps(v)
+}
+
+func flattenNestedComputePerInstanceConfigPreservedStateExternalIp(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	return flattenComputePreservedStateNetworkIps(v)
+}
+
 func expandNestedComputePerInstanceConfigName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
@@ -598,6 +624,20 @@ func expandNestedComputePerInstanceConfigPreservedState(v interface{}, d Terrafo
 		transformed["disks"] = transformedDisk
 	}
 
+	transformedInternalIp, err := expandNestedComputePerInstanceConfigPreservedStateInternalIp(original["internal_ip"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedInternalIp); val.IsValid() && !isEmptyValue(val) {
+		transformed["internalIPs"] = transformedInternalIp
+	}
+
+	transformedExternalIp, err := expandNestedComputePerInstanceConfigPreservedStateExternalIp(original["external_ip"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedExternalIp); val.IsValid() && !isEmptyValue(val) {
+		transformed["externalIPs"] = transformedExternalIp
+	}
+
 	return transformed, nil
 }
 
@@ -642,6 +682,14 @@ func expandNestedComputePerInstanceConfigPreservedStateDisk(v interface{}, d Ter
 	return req, nil
 }
 
+func expandNestedComputePerInstanceConfigPreservedStateInternalIp(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return expandComputePreservedStateNetworkIps(v)
+}
+
+func expandNestedComputePerInstanceConfigPreservedStateExternalIp(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return expandComputePreservedStateNetworkIps(v)
+}
+
 func resourceComputePerInstanceConfigEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
 	wrappedReq := map[string]interface{}{
 		"instances": []interface{}{obj},
@@ -125,6 +125,41 @@ func TestAccComputePerInstanceConfig_update(t *testing.T) {
 	})
 }
 
+func TestAccComputePerInstanceConfig_statefulIps(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+		"igm_name":      fmt.Sprintf("tf-test-igm-%s", randString(t, 10)),
+		"config_name":   fmt.Sprintf("instance-%s", randString(t, 10)),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputePerInstanceConfig_statefulIpsBasic(context),
+			},
+			{
+				ResourceName:            "google_compute_per_instance_config.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"remove_instance_state_on_destroy", "zone"},
+			},
+			{
+				Config: testAccComputePerInstanceConfig_statefulIpsUpdate(context),
+			},
+			{
+				ResourceName:            "google_compute_per_instance_config.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"remove_instance_state_on_destroy", "zone"},
+			},
+		},
+	})
+}
+
 func testAccComputePerInstanceConfig_statefulBasic(context map[string]interface{}) string {
 	return Nprintf(`
 resource "google_compute_per_instance_config" "default" {
@@ -295,6 +330,156 @@ resource "google_compute_instance_group_manager" "igm" {
 `, context)
 }
 
+func testAccComputePerInstanceConfig_statefulIpsBasic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "default" {
+  name                    = "tf-test-network-%{random_suffix}"
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "tf-test-subnetwork-%{random_suffix}"
+  ip_cidr_range = "10.0.0.0/16"
+  region        = "us-central1"
+  network       = google_compute_network.default.id
+}
+
+resource "google_compute_address" "static_internal_ip" {
+  name         = "tf-test-sip-%{random_suffix}"
+  address_type = "INTERNAL"
+  region       = "us-central1"
+  subnetwork   = google_compute_subnetwork.default.id
+}
+
+resource "google_compute_address" "static_external_ip" {
+  name         = "tf-test-sep-%{random_suffix}"
+  address_type = "EXTERNAL"
+  region       = "us-central1"
+}
+
+resource "google_compute_per_instance_config" "default" {
+	zone = google_compute_instance_group_manager.igm.zone
+	instance_group_manager = google_compute_instance_group_manager.igm.name
+	name = "%{config_name}"
+	remove_instance_state_on_destroy = true
+	preserved_state {
+		metadata = {
+			asdf = "asdf"
+		}
+
+		internal_ip {
+			interface_name = "nic0"
+			ip_address {
+				address = google_compute_address.static_internal_ip.id
+			}
+			auto_delete = "NEVER"
+		}
+
+		external_ip {
+			interface_name = "nic0"
+			ip_address {
+				address = google_compute_address.static_external_ip.id
+			}
+			auto_delete = "ON_PERMANENT_INSTANCE_DELETION"
+		}
+	}
+}
+`, context) + testAccComputePerInstanceConfig_igmWithNetwork(context)
+}
+
+func testAccComputePerInstanceConfig_statefulIpsUpdate(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "default" {
+  name                    = "tf-test-network-%{random_suffix}"
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "tf-test-subnetwork-%{random_suffix}"
+  ip_cidr_range = "10.0.0.0/16"
+  region        = "us-central1"
+  network       = google_compute_network.default.id
+}
+
+resource "google_compute_address" "static_internal_ip" {
+  name         = "tf-test-sip-%{random_suffix}"
+  address_type = "INTERNAL"
+  region       = "us-central1"
+  subnetwork   = google_compute_subnetwork.default.id
+}
+
+resource "google_compute_address" "static_external_ip" {
+  name         = "tf-test-sep-%{random_suffix}"
+  address_type = "EXTERNAL"
+  region       = "us-central1"
+}
+
+resource "google_compute_per_instance_config" "default" {
+	zone = google_compute_instance_group_manager.igm.zone
+	instance_group_manager = google_compute_instance_group_manager.igm.name
+	name = "%{config_name}"
+	remove_instance_state_on_destroy = true
+	preserved_state {
+		metadata = {
+			asdf = "asdf"
+		}
+
+		internal_ip {
+			interface_name = "nic0"
+			ip_address {
+				address = google_compute_address.static_internal_ip.id
+			}
+			auto_delete = "ON_PERMANENT_INSTANCE_DELETION"
+		}
+	}
+}
+`, context) + testAccComputePerInstanceConfig_igmWithNetwork(context)
+}
+
+func testAccComputePerInstanceConfig_igmWithNetwork(context map[string]interface{}) string {
+	return Nprintf(`
+data "google_compute_image" "my_image" {
+  family  = "debian-11"
+  project = "debian-cloud"
+}
+
+resource "google_compute_instance_template" "igm-basic" {
+  name           = "tf-test-igm-%{random_suffix}"
+  machine_type   = "e2-medium"
+  can_ip_forward = false
+  tags           = ["foo", "bar"]
+
+  disk {
+    source_image = data.google_compute_image.my_image.self_link
+    auto_delete  = true
+    boot         = true
+    device_name  = "my-stateful-disk"
+  }
+
+  network_interface {
+    network    = google_compute_network.default.id
+    subnetwork = google_compute_subnetwork.default.id
+    access_config {}
+  }
+
+  service_account {
+    scopes = ["userinfo-email", "compute-ro", "storage-ro"]
+  }
+}
+
+resource "google_compute_instance_group_manager" "igm" {
+  description = "Terraform test instance group manager"
+  name        = "%{igm_name}"
+  zone        = "us-central1-c"
+
+  version {
+    name              = "prod"
+    instance_template = google_compute_instance_template.igm-basic.self_link
+  }
+
+  base_instance_name = "tf-test-igm-no-tp"
+}
+`, context)
+}
+
 // Checks that the per instance config with the given name was destroyed
 func testAccCheckComputePerInstanceConfigDestroyed(t *testing.T, igmId, configName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -1354,6 +1354,7 @@ resource "google_compute_instance_template" "igm-basic" {
   }
   network_interface {
     network = "default"
+    access_config {}
   }
 }
 
@@ -1380,6 +1381,17 @@ resource "google_compute_region_instance_group_manager" "igm-basic" {
     device_name = "stateful-disk"
     delete_rule = "NEVER"
   }
+  stateful_internal_ip {
+    interface_name = "nic0"
+    delete_rule    = "NEVER"
+  }
+  stateful_external_ip {
+    interface_name = "nic0"
+    delete_rule    = "NEVER"
+  }
+  instance_lifecycle_policy {
+    force_update_on_repair = "YES"
+  }
 }
 `, template, igm)
 }
@@ -1409,6 +1421,7 @@ resource "google_compute_instance_template" "igm-basic" {
   }
   network_interface {
     network = "default"
+    access_config {}
   }
 }
 
@@ -1440,6 +1453,13 @@ resource "google_compute_region_instance_group_manager" "igm-basic" {
     device_name = "stateful-disk2"
     delete_rule = "ON_PERMANENT_INSTANCE_DELETION"
   }
+  stateful_internal_ip {
+    interface_name = "nic0"
+    delete_rule    = "ON_PERMANENT_INSTANCE_DELETION"
+  }
+  instance_lifecycle_policy {
+    force_update_on_repair = "NO"
+  }
 }
 `, template, igm)
 }
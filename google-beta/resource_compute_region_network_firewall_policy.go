@@ -0,0 +1,36 @@
+package google
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceComputeRegionNetworkFirewallPolicy() *schema.Resource {
+	schm := &schema.Resource{
+		Create: resourceComputeNetworkFirewallPolicyCreate(true),
+		Read:   resourceComputeNetworkFirewallPolicyRead(true),
+		Update: resourceComputeNetworkFirewallPolicyUpdate(true),
+		Delete: resourceComputeNetworkFirewallPolicyDelete(true),
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeNetworkFirewallPolicyImport(true),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema:        computeNetworkFirewallPolicySchema(),
+		UseJSONNumber: true,
+	}
+	schm.Schema["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: `The region of this resource. If it is not provided, the provider region is used.`,
+	}
+	return schm
+}
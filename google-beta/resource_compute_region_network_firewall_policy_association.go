@@ -0,0 +1,34 @@
+package google
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceComputeRegionNetworkFirewallPolicyAssociation() *schema.Resource {
+	schm := &schema.Resource{
+		Create: resourceComputeNetworkFirewallPolicyAssociationCreate(true),
+		Read:   resourceComputeNetworkFirewallPolicyAssociationRead(true),
+		Delete: resourceComputeNetworkFirewallPolicyAssociationDelete(true),
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeNetworkFirewallPolicyAssociationImport(true),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema:        computeNetworkFirewallPolicyAssociationSchema(),
+		UseJSONNumber: true,
+	}
+	schm.Schema["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: `The region of this resource. If it is not provided, the provider region is used.`,
+	}
+	return schm
+}
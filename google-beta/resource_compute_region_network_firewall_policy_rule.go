@@ -0,0 +1,36 @@
+package google
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceComputeRegionNetworkFirewallPolicyRule() *schema.Resource {
+	schm := &schema.Resource{
+		Create: resourceComputeNetworkFirewallPolicyRuleCreate(true),
+		Read:   resourceComputeNetworkFirewallPolicyRuleRead(true),
+		Update: resourceComputeNetworkFirewallPolicyRuleUpdate(true),
+		Delete: resourceComputeNetworkFirewallPolicyRuleDelete(true),
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeNetworkFirewallPolicyRuleImport(true),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema:        computeNetworkFirewallPolicyRuleSchema(),
+		UseJSONNumber: true,
+	}
+	schm.Schema["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: `The region of this resource. If it is not provided, the provider region is used.`,
+	}
+	return schm
+}
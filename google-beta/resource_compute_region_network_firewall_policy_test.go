@@ -0,0 +1,118 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeRegionNetworkFirewallPolicy_rules(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNetworkFirewallPolicyDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionNetworkFirewallPolicy_rules(context, "allow"),
+			},
+			{
+				ResourceName:      "google_compute_region_network_firewall_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_compute_region_network_firewall_policy_rule.rule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_compute_region_network_firewall_policy_association.association",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRegionNetworkFirewallPolicy_rules(context, "deny"),
+			},
+			{
+				ResourceName:      "google_compute_region_network_firewall_policy_rule.rule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestNetworkFirewallPolicyRef(t *testing.T) {
+	cases := map[string]struct {
+		Ref          networkFirewallPolicyRef
+		ExpectedId   string
+		ExpectedLock string
+	}{
+		"global": {
+			Ref:          networkFirewallPolicyRef{project: "my-project", name: "my-policy"},
+			ExpectedId:   "projects/my-project/global/firewallPolicies/my-policy",
+			ExpectedLock: "networkFirewallPolicy/projects/my-project/global/firewallPolicies/my-policy",
+		},
+		"regional": {
+			Ref:          networkFirewallPolicyRef{project: "my-project", region: "us-central1", name: "my-policy"},
+			ExpectedId:   "projects/my-project/regions/us-central1/firewallPolicies/my-policy",
+			ExpectedLock: "networkFirewallPolicy/projects/my-project/regions/us-central1/firewallPolicies/my-policy",
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := tc.Ref.id(); got != tc.ExpectedId {
+			t.Errorf("bad: %s, expected id %q, got %q", tn, tc.ExpectedId, got)
+		}
+		if got := tc.Ref.lockName(); got != tc.ExpectedLock {
+			t.Errorf("bad: %s, expected lock name %q, got %q", tn, tc.ExpectedLock, got)
+		}
+	}
+}
+
+func testAccComputeRegionNetworkFirewallPolicy_rules(context map[string]interface{}, action string) string {
+	context["action"] = action
+	return Nprintf(`
+resource "google_compute_network" "network" {
+  name                    = "tf-test-network-%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_region_network_firewall_policy" "policy" {
+  name        = "tf-test-policy-%{random_suffix}"
+  region      = "us-central1"
+  description = "Sample regional network firewall policy"
+}
+
+resource "google_compute_region_network_firewall_policy_association" "association" {
+  name              = "tf-test-association-%{random_suffix}"
+  region            = "us-central1"
+  attachment_target = google_compute_network.network.id
+  firewall_policy   = google_compute_region_network_firewall_policy.policy.name
+}
+
+resource "google_compute_region_network_firewall_policy_rule" "rule" {
+  firewall_policy = google_compute_region_network_firewall_policy.policy.name
+  region          = "us-central1"
+  priority        = 1000
+  action          = "%{action}"
+  direction       = "INGRESS"
+
+  match {
+    src_ip_ranges = ["10.100.0.1/32"]
+    src_fqdns     = ["example.com"]
+
+    layer4_configs {
+      ip_protocol = "tcp"
+      ports       = ["22"]
+    }
+  }
+}
+`, context)
+}
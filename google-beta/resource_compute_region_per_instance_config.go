@@ -68,6 +68,20 @@ func resourceComputeRegionPerInstanceConfig() *schema.Resource {
 							Elem:        computeRegionPerInstanceConfigPreservedStateDiskSchema(),
 							// Default schema.HashSchema is used.
 						},
+						"external_ip": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: `Preserved external IPs defined for this instance. This map is keyed with the name of the network interface.`,
+							Elem:        computePreservedStateNetworkIpSchema(),
+							// Default schema.HashSchema is used.
+						},
+						"internal_ip": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: `Preserved internal IPs defined for this instance. This map is keyed with the name of the network interface.`,
+							Elem:        computePreservedStateNetworkIpSchema(),
+							// Default schema.HashSchema is used.
+						},
 						"metadata": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -553,6 +567,10 @@ func flattenNestedComputeRegionPerInstanceConfigPreservedState(v interface{}, d
 		flattenNestedComputeRegionPerInstanceConfigPreservedStateMetadata(original["metadata"], d, config)
 	transformed["disk"] =
 		flattenNestedComputeRegionPerInstanceConfigPreservedStateDisk(original["disks"], d, config)
+	transformed["internal_ip"] =
+		flattenNestedComputeRegionPerInstanceConfigPreservedStateInternalIp(original["internalIPs"], d, config)
+	transformed["external_ip"] =
+		flattenNestedComputeRegionPerInstanceConfigPreservedStateExternalIp(original["externalIPs"], d, config)
 	return []interface{}{transformed}
 }
 func flattenNestedComputeRegionPerInstanceConfigPreservedStateMetadata(v interface{}, d *schema.ResourceData, config *Config) interface{} {
@@ -581,6 +599,14 @@ func flattenNestedComputeRegionPerInstanceConfigPreservedStateDisk(v interface{}
 	return transformed
 }
 
+func flattenNestedComputeRegionPerInstanceConfigPreservedStateInternalIp(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	return flattenComputePreservedStateNetworkIps(v)
+}
+
+func flattenNestedComputeRegionPerInstanceConfigPreservedStateExternalIp(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	return flattenComputePreservedStateNetworkIps(v)
+}
+
 func expandNestedComputeRegionPerInstanceConfigName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
@@ -608,6 +634,20 @@ func expandNestedComputeRegionPerInstanceConfigPreservedState(v interface{}, d T
 		transformed["disks"] = transformedDisk
 	}
 
+	transformedInternalIp, err := expandNestedComputeRegionPerInstanceConfigPreservedStateInternalIp(original["internal_ip"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedInternalIp); val.IsValid() && !isEmptyValue(val) {
+		transformed["internalIPs"] = transformedInternalIp
+	}
+
+	transformedExternalIp, err := expandNestedComputeRegionPerInstanceConfigPreservedStateExternalIp(original["external_ip"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedExternalIp); val.IsValid() && !isEmptyValue(val) {
+		transformed["externalIPs"] = transformedExternalIp
+	}
+
 	return transformed, nil
 }
 
@@ -652,6 +692,14 @@ func expandNestedComputeRegionPerInstanceConfigPreservedStateDisk(v interface{},
 	return req, nil
 }
 
+func expandNestedComputeRegionPerInstanceConfigPreservedStateInternalIp(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return expandComputePreservedStateNetworkIps(v)
+}
+
+func expandNestedComputeRegionPerInstanceConfigPreservedStateExternalIp(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return expandComputePreservedStateNetworkIps(v)
+}
+
 func resourceComputeRegionPerInstanceConfigEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
 	wrappedReq := map[string]interface{}{
 		"instances": []interface{}{obj},
@@ -125,6 +125,41 @@ func TestAccComputeRegionPerInstanceConfig_update(t *testing.T) {
 	})
 }
 
+func TestAccComputeRegionPerInstanceConfig_statefulIps(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+		"rigm_name":     fmt.Sprintf("tf-test-rigm-%s", randString(t, 10)),
+		"config_name":   fmt.Sprintf("instance-%s", randString(t, 10)),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionPerInstanceConfig_statefulIpsBasic(context),
+			},
+			{
+				ResourceName:            "google_compute_region_per_instance_config.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"remove_instance_state_on_destroy", "region"},
+			},
+			{
+				Config: testAccComputeRegionPerInstanceConfig_statefulIpsUpdate(context),
+			},
+			{
+				ResourceName:            "google_compute_region_per_instance_config.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"remove_instance_state_on_destroy", "region"},
+			},
+		},
+	})
+}
+
 func testAccComputeRegionPerInstanceConfig_statefulBasic(context map[string]interface{}) string {
 	return Nprintf(`
 resource "google_compute_region_per_instance_config" "default" {
@@ -303,6 +338,164 @@ resource "google_compute_region_instance_group_manager" "rigm" {
 `, context)
 }
 
+func testAccComputeRegionPerInstanceConfig_statefulIpsBasic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "default" {
+  name                    = "tf-test-network-%{random_suffix}"
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "tf-test-subnetwork-%{random_suffix}"
+  ip_cidr_range = "10.0.0.0/16"
+  region        = "us-central1"
+  network       = google_compute_network.default.id
+}
+
+resource "google_compute_address" "static_internal_ip" {
+  name         = "tf-test-sip-%{random_suffix}"
+  address_type = "INTERNAL"
+  region       = "us-central1"
+  subnetwork   = google_compute_subnetwork.default.id
+}
+
+resource "google_compute_address" "static_external_ip" {
+  name         = "tf-test-sep-%{random_suffix}"
+  address_type = "EXTERNAL"
+  region       = "us-central1"
+}
+
+resource "google_compute_region_per_instance_config" "default" {
+	region = google_compute_region_instance_group_manager.rigm.region
+	region_instance_group_manager = google_compute_region_instance_group_manager.rigm.name
+	name = "%{config_name}"
+	remove_instance_state_on_destroy = true
+	preserved_state {
+		metadata = {
+			asdf = "asdf"
+		}
+
+		internal_ip {
+			interface_name = "nic0"
+			ip_address {
+				address = google_compute_address.static_internal_ip.id
+			}
+			auto_delete = "NEVER"
+		}
+
+		external_ip {
+			interface_name = "nic0"
+			ip_address {
+				address = google_compute_address.static_external_ip.id
+			}
+			auto_delete = "ON_PERMANENT_INSTANCE_DELETION"
+		}
+	}
+}
+`, context) + testAccComputeRegionPerInstanceConfig_rigmWithNetwork(context)
+}
+
+func testAccComputeRegionPerInstanceConfig_statefulIpsUpdate(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "default" {
+  name                    = "tf-test-network-%{random_suffix}"
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "tf-test-subnetwork-%{random_suffix}"
+  ip_cidr_range = "10.0.0.0/16"
+  region        = "us-central1"
+  network       = google_compute_network.default.id
+}
+
+resource "google_compute_address" "static_internal_ip" {
+  name         = "tf-test-sip-%{random_suffix}"
+  address_type = "INTERNAL"
+  region       = "us-central1"
+  subnetwork   = google_compute_subnetwork.default.id
+}
+
+resource "google_compute_address" "static_external_ip" {
+  name         = "tf-test-sep-%{random_suffix}"
+  address_type = "EXTERNAL"
+  region       = "us-central1"
+}
+
+resource "google_compute_region_per_instance_config" "default" {
+	region = google_compute_region_instance_group_manager.rigm.region
+	region_instance_group_manager = google_compute_region_instance_group_manager.rigm.name
+	name = "%{config_name}"
+	remove_instance_state_on_destroy = true
+	preserved_state {
+		metadata = {
+			asdf = "asdf"
+		}
+
+		internal_ip {
+			interface_name = "nic0"
+			ip_address {
+				address = google_compute_address.static_internal_ip.id
+			}
+			auto_delete = "ON_PERMANENT_INSTANCE_DELETION"
+		}
+	}
+}
+`, context) + testAccComputeRegionPerInstanceConfig_rigmWithNetwork(context)
+}
+
+func testAccComputeRegionPerInstanceConfig_rigmWithNetwork(context map[string]interface{}) string {
+	return Nprintf(`
+data "google_compute_image" "my_image" {
+  family  = "debian-11"
+  project = "debian-cloud"
+}
+
+resource "google_compute_instance_template" "rigm-basic" {
+  name           = "tf-test-rigm-%{random_suffix}"
+  machine_type   = "e2-medium"
+  can_ip_forward = false
+  tags           = ["foo", "bar"]
+
+  disk {
+    source_image = data.google_compute_image.my_image.self_link
+    auto_delete  = true
+    boot         = true
+    device_name  = "my-stateful-disk"
+  }
+
+  network_interface {
+    network    = google_compute_network.default.id
+    subnetwork = google_compute_subnetwork.default.id
+    access_config {}
+  }
+
+  service_account {
+    scopes = ["userinfo-email", "compute-ro", "storage-ro"]
+  }
+}
+
+resource "google_compute_region_instance_group_manager" "rigm" {
+  description = "Terraform test instance group manager"
+  name        = "%{rigm_name}"
+  region      = "us-central1"
+
+  version {
+    name              = "prod"
+    instance_template = google_compute_instance_template.rigm-basic.self_link
+  }
+
+  base_instance_name = "tf-test-rigm-no-tp"
+
+  update_policy {
+    instance_redistribution_type = "NONE"
+    type                         = "OPPORTUNISTIC"
+    minimal_action               = "REPLACE"
+    max_surge_fixed              = 0
+    max_unavailable_fixed        = 6
+  }
+}
+`, context)
+}
+
 // Checks that the per instance config with the given name was destroyed
 func testAccCheckComputeRegionPerInstanceConfigDestroyed(t *testing.T, rigmId, configName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -15,6 +15,7 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
@@ -23,6 +24,97 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+func resourceComputeRegionUrlMapValidateOnPlanCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("validate_on_plan").(bool) {
+		return nil
+	}
+	// Nothing to validate if an existing url map has no pending changes.
+	if diff.Id() != "" && len(diff.GetChangedKeysPrefix("")) == 0 {
+		return nil
+	}
+	// Referenced backends that are not created yet can't be validated against.
+	if !urlMapConfigWhollyKnown(diff) {
+		return nil
+	}
+
+	config := meta.(*Config)
+	d := resourceDiffData{diff}
+
+	obj, err := resourceComputeRegionUrlMapExpand(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/urlMaps/{{name}}/validate")
+	if err != nil {
+		return err
+	}
+
+	return validateUrlMapOnPlan(config, d, url, obj)
+}
+
+// resourceComputeRegionUrlMapExpand builds the request body sent to create the
+// region url map, which is also validated on plan when validate_on_plan is set.
+func resourceComputeRegionUrlMapExpand(d TerraformResourceData, config *Config) (map[string]interface{}, error) {
+	obj := make(map[string]interface{})
+	defaultServiceProp, err := expandComputeRegionUrlMapDefaultService(d.Get("default_service"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("default_service"); !isEmptyValue(reflect.ValueOf(defaultServiceProp)) && (ok || !reflect.DeepEqual(v, defaultServiceProp)) {
+		obj["defaultService"] = defaultServiceProp
+	}
+	descriptionProp, err := expandComputeRegionUrlMapDescription(d.Get("description"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	hostRulesProp, err := expandComputeRegionUrlMapHostRule(d.Get("host_rule"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("host_rule"); !isEmptyValue(reflect.ValueOf(hostRulesProp)) && (ok || !reflect.DeepEqual(v, hostRulesProp)) {
+		obj["hostRules"] = hostRulesProp
+	}
+	fingerprintProp, err := expandComputeRegionUrlMapFingerprint(d.Get("fingerprint"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("fingerprint"); !isEmptyValue(reflect.ValueOf(fingerprintProp)) && (ok || !reflect.DeepEqual(v, fingerprintProp)) {
+		obj["fingerprint"] = fingerprintProp
+	}
+	nameProp, err := expandComputeRegionUrlMapName(d.Get("name"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	pathMatchersProp, err := expandComputeRegionUrlMapPathMatcher(d.Get("path_matcher"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("path_matcher"); !isEmptyValue(reflect.ValueOf(pathMatchersProp)) && (ok || !reflect.DeepEqual(v, pathMatchersProp)) {
+		obj["pathMatchers"] = pathMatchersProp
+	}
+	testsProp, err := expandComputeRegionUrlMapTest(d.Get("test"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("test"); !isEmptyValue(reflect.ValueOf(testsProp)) && (ok || !reflect.DeepEqual(v, testsProp)) {
+		obj["tests"] = testsProp
+	}
+	defaultUrlRedirectProp, err := expandComputeRegionUrlMapDefaultUrlRedirect(d.Get("default_url_redirect"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("default_url_redirect"); !isEmptyValue(reflect.ValueOf(defaultUrlRedirectProp)) && (ok || !reflect.DeepEqual(v, defaultUrlRedirectProp)) {
+		obj["defaultUrlRedirect"] = defaultUrlRedirectProp
+	}
+	regionProp, err := expandComputeRegionUrlMapRegion(d.Get("region"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("region"); !isEmptyValue(reflect.ValueOf(regionProp)) && (ok || !reflect.DeepEqual(v, regionProp)) {
+		obj["region"] = regionProp
+	}
+
+	return obj, nil
+}
+
 func resourceComputeRegionUrlMap() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeRegionUrlMapCreate,
@@ -40,6 +132,8 @@ func resourceComputeRegionUrlMap() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: resourceComputeRegionUrlMapValidateOnPlanCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -1696,6 +1790,13 @@ updates of this resource.`,
 				Computed:    true,
 				Description: `The unique identifier for the resource.`,
 			},
+			"validate_on_plan": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: `If set to true, the url map and its tests are validated with the urlMaps.validate API during plan,
+as long as all referenced backends are known. Load errors and failing tests are reported as plan errors.`,
+			},
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -1749,60 +1850,9 @@ func resourceComputeRegionUrlMapCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	obj := make(map[string]interface{})
-	defaultServiceProp, err := expandComputeRegionUrlMapDefaultService(d.Get("default_service"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("default_service"); !isEmptyValue(reflect.ValueOf(defaultServiceProp)) && (ok || !reflect.DeepEqual(v, defaultServiceProp)) {
-		obj["defaultService"] = defaultServiceProp
-	}
-	descriptionProp, err := expandComputeRegionUrlMapDescription(d.Get("description"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
-		obj["description"] = descriptionProp
-	}
-	hostRulesProp, err := expandComputeRegionUrlMapHostRule(d.Get("host_rule"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("host_rule"); !isEmptyValue(reflect.ValueOf(hostRulesProp)) && (ok || !reflect.DeepEqual(v, hostRulesProp)) {
-		obj["hostRules"] = hostRulesProp
-	}
-	fingerprintProp, err := expandComputeRegionUrlMapFingerprint(d.Get("fingerprint"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("fingerprint"); !isEmptyValue(reflect.ValueOf(fingerprintProp)) && (ok || !reflect.DeepEqual(v, fingerprintProp)) {
-		obj["fingerprint"] = fingerprintProp
-	}
-	nameProp, err := expandComputeRegionUrlMapName(d.Get("name"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
-		obj["name"] = nameProp
-	}
-	pathMatchersProp, err := expandComputeRegionUrlMapPathMatcher(d.Get("path_matcher"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("path_matcher"); !isEmptyValue(reflect.ValueOf(pathMatchersProp)) && (ok || !reflect.DeepEqual(v, pathMatchersProp)) {
-		obj["pathMatchers"] = pathMatchersProp
-	}
-	testsProp, err := expandComputeRegionUrlMapTest(d.Get("test"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("test"); !isEmptyValue(reflect.ValueOf(testsProp)) && (ok || !reflect.DeepEqual(v, testsProp)) {
-		obj["tests"] = testsProp
-	}
-	defaultUrlRedirectProp, err := expandComputeRegionUrlMapDefaultUrlRedirect(d.Get("default_url_redirect"), d, config)
+	obj, err := resourceComputeRegionUrlMapExpand(d, config)
 	if err != nil {
 		return err
-	} else if v, ok := d.GetOkExists("default_url_redirect"); !isEmptyValue(reflect.ValueOf(defaultUrlRedirectProp)) && (ok || !reflect.DeepEqual(v, defaultUrlRedirectProp)) {
-		obj["defaultUrlRedirect"] = defaultUrlRedirectProp
-	}
-	regionProp, err := expandComputeRegionUrlMapRegion(d.Get("region"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("region"); !isEmptyValue(reflect.ValueOf(regionProp)) && (ok || !reflect.DeepEqual(v, regionProp)) {
-		obj["region"] = regionProp
 	}
 
 	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/urlMaps")
@@ -1881,6 +1931,13 @@ func resourceComputeRegionUrlMapRead(d *schema.ResourceData, meta interface{}) e
 		return handleNotFoundError(err, d, fmt.Sprintf("ComputeRegionUrlMap %q", d.Id()))
 	}
 
+	// Explicitly set virtual fields to default values if unset
+	if _, ok := d.GetOkExists("validate_on_plan"); !ok {
+		if err := d.Set("validate_on_plan", false); err != nil {
+			return fmt.Errorf("Error setting validate_on_plan: %s", err)
+		}
+	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading RegionUrlMap: %s", err)
 	}
@@ -2090,6 +2147,11 @@ func resourceComputeRegionUrlMapImport(d *schema.ResourceData, meta interface{})
 	}
 	d.SetId(id)
 
+	// Explicitly set virtual fields to default values on import
+	if err := d.Set("validate_on_plan", false); err != nil {
+		return nil, fmt.Errorf("Error setting validate_on_plan: %s", err)
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
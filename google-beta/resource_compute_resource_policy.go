@@ -57,6 +57,24 @@ which cannot be a dash.`,
 				ForceNew:    true,
 				Description: `An optional description of this resource. Provide this property when you create the resource.`,
 			},
+			"disk_consistency_group_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Replication consistency group for asynchronous disk replication.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							ForceNew:    true,
+							Description: `Enable disk consistency on the resource policy.`,
+						},
+					},
+				},
+				ConflictsWith: []string{"snapshot_schedule_policy", "group_placement_policy", "instance_schedule_policy"},
+			},
 			"group_placement_policy": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -94,7 +112,7 @@ exact number of VMs.`,
 						},
 					},
 				},
-				ConflictsWith: []string{"instance_schedule_policy", "snapshot_schedule_policy"},
+				ConflictsWith: []string{"instance_schedule_policy", "snapshot_schedule_policy", "disk_consistency_group_policy"},
 			},
 			"instance_schedule_policy": {
 				Type:        schema.TypeList,
@@ -161,7 +179,7 @@ from the tz database: http://en.wikipedia.org/wiki/Tz_database.`,
 						},
 					},
 				},
-				ConflictsWith: []string{"snapshot_schedule_policy", "group_placement_policy"},
+				ConflictsWith: []string{"snapshot_schedule_policy", "group_placement_policy", "disk_consistency_group_policy"},
 			},
 			"region": {
 				Type:             schema.TypeString,
@@ -334,7 +352,7 @@ the source disk is deleted. Default value: "KEEP_AUTO_SNAPSHOTS" Possible values
 						},
 					},
 				},
-				ConflictsWith: []string{"group_placement_policy", "instance_schedule_policy"},
+				ConflictsWith: []string{"group_placement_policy", "instance_schedule_policy", "disk_consistency_group_policy"},
 			},
 			"project": {
 				Type:     schema.TypeString,
@@ -410,6 +428,13 @@ func resourceComputeResourcePolicyCreate(d *schema.ResourceData, meta interface{
 	} else if v, ok := d.GetOkExists("instance_schedule_policy"); !isEmptyValue(reflect.ValueOf(instanceSchedulePolicyProp)) && (ok || !reflect.DeepEqual(v, instanceSchedulePolicyProp)) {
 		obj["instanceSchedulePolicy"] = instanceSchedulePolicyProp
 	}
+	diskConsistencyGroupPolicyProp, err := expandComputeResourcePolicyDiskConsistencyGroupPolicy(d.Get("disk_consistency_group_policy"), d, config)
+	if err != nil {
+		return err
+	} else if diskConsistencyGroupPolicyProp != nil {
+		// The policy is an empty object, so it has to be sent even though it's empty.
+		obj["diskConsistencyGroupPolicy"] = diskConsistencyGroupPolicyProp
+	}
 	regionProp, err := expandComputeResourcePolicyRegion(d.Get("region"), d, config)
 	if err != nil {
 		return err
@@ -512,6 +537,9 @@ func resourceComputeResourcePolicyRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("instance_schedule_policy", flattenComputeResourcePolicyInstanceSchedulePolicy(res["instanceSchedulePolicy"], d, config)); err != nil {
 		return fmt.Errorf("Error reading ResourcePolicy: %s", err)
 	}
+	if err := d.Set("disk_consistency_group_policy", flattenComputeResourcePolicyDiskConsistencyGroupPolicy(res["diskConsistencyGroupPolicy"], d, config)); err != nil {
+		return fmt.Errorf("Error reading ResourcePolicy: %s", err)
+	}
 	if err := d.Set("region", flattenComputeResourcePolicyRegion(res["region"], d, config)); err != nil {
 		return fmt.Errorf("Error reading ResourcePolicy: %s", err)
 	}
@@ -932,6 +960,17 @@ func flattenComputeResourcePolicyInstanceSchedulePolicyExpirationTime(v interfac
 	return v
 }
 
+// diskConsistencyGroupPolicy is an empty message, its presence is what enables it.
+func flattenComputeResourcePolicyDiskConsistencyGroupPolicy(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	if v == nil {
+		if _, ok := d.GetOk("disk_consistency_group_policy"); ok {
+			return []interface{}{map[string]interface{}{"enabled": false}}
+		}
+		return nil
+	}
+	return []interface{}{map[string]interface{}{"enabled": true}}
+}
+
 func flattenComputeResourcePolicyRegion(v interface{}, d *schema.ResourceData, config *Config) interface{} {
 	if v == nil {
 		return v
@@ -1375,6 +1414,20 @@ func expandComputeResourcePolicyInstanceSchedulePolicyExpirationTime(v interface
 	return v, nil
 }
 
+func expandComputeResourcePolicyDiskConsistencyGroupPolicy(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	if !original["enabled"].(bool) {
+		return nil, nil
+	}
+
+	return map[string]interface{}{}, nil
+}
+
 func expandComputeResourcePolicyRegion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	f, err := parseGlobalFieldValue("regions", v.(string), "project", d, config, true)
 	if err != nil {
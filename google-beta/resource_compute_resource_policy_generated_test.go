@@ -200,6 +200,43 @@ resource "google_compute_resource_policy" "hourly" {
 `, context)
 }
 
+func TestAccComputeResourcePolicy_resourcePolicyConsistencyGroupExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeResourcePolicyDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeResourcePolicy_resourcePolicyConsistencyGroupExample(context),
+			},
+			{
+				ResourceName:            "google_compute_resource_policy.cgroup",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"region"},
+			},
+		},
+	})
+}
+
+func testAccComputeResourcePolicy_resourcePolicyConsistencyGroupExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_resource_policy" "cgroup" {
+  name   = "tf-test-gce-policy%{random_suffix}"
+  region = "europe-west1"
+  disk_consistency_group_policy {
+    enabled = true
+  }
+}
+`, context)
+}
+
 func testAccCheckComputeResourcePolicyDestroyProducer(t *testing.T) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
 		for name, rs := range s.RootModule().Resources {
@@ -22,7 +22,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNameSetFromSelfLinkSet(v interface{}) *schema.Set {
@@ -76,6 +78,32 @@ func resourceComputeRouterNatDrainNatIpsCustomDiff(_ context.Context, diff *sche
 	return nil
 }
 
+// nat_ips and drain_nat_ips are managed by google_compute_router_nat_address
+// when the NAT was created with initial_nat_ips, so their diffs are suppressed.
+func routerNatIpsManagedByNatAddress(_, _, _ string, d *schema.ResourceData) bool {
+	v, ok := d.GetOk("initial_nat_ips")
+	return ok && v.(*schema.Set).Len() > 0
+}
+
+// nat_ip_allocate_option MUST be set for public NAT, while private NAT
+// translates to the subnetworks selected by its rules and MUST NOT set it.
+func resourceComputeRouterNatTypeCustomDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("nat_ip_allocate_option") {
+		return nil
+	}
+	natIpAllocateOption := diff.Get("nat_ip_allocate_option").(string)
+	if diff.Get("type").(string) == "PRIVATE" {
+		if natIpAllocateOption != "" {
+			return fmt.Errorf("nat_ip_allocate_option cannot be set for a RouterNat of type PRIVATE")
+		}
+		return nil
+	}
+	if natIpAllocateOption == "" {
+		return fmt.Errorf("nat_ip_allocate_option is required for a RouterNat of type PUBLIC")
+	}
+	return nil
+}
+
 func computeRouterNatSubnetworkHash(v interface{}) int {
 	obj := v.(map[string]interface{})
 	name := obj["name"]
@@ -111,6 +139,35 @@ func computeRouterNatIPsHash(v interface{}) int {
 	return schema.HashString(GetResourceNameFromSelfLink(val))
 }
 
+func computeRouterNatRulesHash(v interface{}) int {
+	obj := v.(map[string]interface{})
+	ruleNumber := fmt.Sprintf("%v", obj["rule_number"])
+	description := ""
+	if v, ok := obj["description"]; ok && v != nil {
+		description = v.(string)
+	}
+	match := ""
+	if v, ok := obj["match"]; ok && v != nil {
+		match = v.(string)
+	}
+
+	actionHash := 0
+	if action, ok := obj["action"].([]interface{}); ok && len(action) > 0 && action[0] != nil {
+		actionObj := action[0].(map[string]interface{})
+		for _, field := range []string{"source_nat_active_ips", "source_nat_drain_ips", "source_nat_active_ranges", "source_nat_drain_ranges"} {
+			links, ok := actionObj[field].(*schema.Set)
+			if !ok {
+				continue
+			}
+			for _, link := range links.List() {
+				actionHash += schema.HashString(field + GetResourceNameFromSelfLink(link.(string)))
+			}
+		}
+	}
+
+	return schema.HashString(ruleNumber) + schema.HashString(description) + schema.HashString(match) + actionHash
+}
+
 func resourceComputeRouterNat() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeRouterNatCreate,
@@ -128,7 +185,10 @@ func resourceComputeRouterNat() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
-		CustomizeDiff: resourceComputeRouterNatDrainNatIpsCustomDiff,
+		CustomizeDiff: customdiff.All(
+			resourceComputeRouterNatDrainNatIpsCustomDiff,
+			resourceComputeRouterNatTypeCustomDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -138,14 +198,6 @@ func resourceComputeRouterNat() *schema.Resource {
 				ValidateFunc: validateRFC1035Name(2, 63),
 				Description: `Name of the NAT service. The name must be 1-63 characters long and
 comply with RFC1035.`,
-			},
-			"nat_ip_allocate_option": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateEnum([]string{"MANUAL_ONLY", "AUTO_ONLY"}),
-				Description: `How external IPs should be allocated for this NAT. Valid values are
-'AUTO_ONLY' for only allowing NAT IPs allocated by Google Cloud
-Platform, or 'MANUAL_ONLY' for only user-allocated NAT IP addresses. Possible values: ["MANUAL_ONLY", "AUTO_ONLY"]`,
 			},
 			"router": {
 				Type:             schema.TypeString,
@@ -170,8 +222,9 @@ ALL_SUBNETWORKS_ALL_PRIMARY_IP_RANGES, then there should not be any
 other RouterNat section in any Router for this network in this region. Possible values: ["ALL_SUBNETWORKS_ALL_IP_RANGES", "ALL_SUBNETWORKS_ALL_PRIMARY_IP_RANGES", "LIST_OF_SUBNETWORKS"]`,
 			},
 			"drain_nat_ips": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:             schema.TypeSet,
+				Optional:         true,
+				DiffSuppressFunc: routerNatIpsManagedByNatAddress,
 				Description: `A list of URLs of the IP resources to be drained. These IPs must be
 valid static external IPs that have been assigned to the NAT.`,
 				Elem: &schema.Schema{
@@ -205,6 +258,21 @@ see the [official documentation](https://cloud.google.com/nat/docs/overview#spec
 				Description: `Timeout (in seconds) for ICMP connections. Defaults to 30s if not set.`,
 				Default:     30,
 			},
+			"initial_nat_ips": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"nat_ips", "drain_nat_ips"},
+				Description: `Self-links of NAT IPs to create the NAT with, when its NAT IPs are
+then managed by 'google_compute_router_nat_address'. Only valid if
+natIpAllocateOption is set to MANUAL_ONLY. Changes to it after the NAT is
+created have no effect, and the NAT leaves 'nat_ips' and 'drain_nat_ips' to
+'google_compute_router_nat_address'.`,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					DiffSuppressFunc: compareSelfLinkOrResourceName,
+				},
+				Set: computeRouterNatIPsHash,
+			},
 			"log_config": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -237,11 +305,23 @@ This field can only be set when enableDynamicPortAllocation is enabled.`,
 				Optional:    true,
 				Description: `Minimum number of ports allocated to a VM from this NAT.`,
 			},
+			"nat_ip_allocate_option": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEnum([]string{"MANUAL_ONLY", "AUTO_ONLY", ""}),
+				Description: `How external IPs should be allocated for this NAT. Valid values are
+'AUTO_ONLY' for only allowing NAT IPs allocated by Google Cloud
+Platform, or 'MANUAL_ONLY' for only user-allocated NAT IP addresses.
+Required for public NAT, and must not be set for private NAT. Possible values: ["MANUAL_ONLY", "AUTO_ONLY"]`,
+			},
 			"nat_ips": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:             schema.TypeSet,
+				Optional:         true,
+				DiffSuppressFunc: routerNatIpsManagedByNatAddress,
 				Description: `Self-links of NAT IPs. Only valid if natIpAllocateOption
-is set to MANUAL_ONLY.`,
+is set to MANUAL_ONLY.
+When the NAT IPs are managed by 'google_compute_router_nat_address', this
+field must not be set, and 'initial_nat_ips' should be used instead.`,
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
 					DiffSuppressFunc: compareSelfLinkOrResourceName,
@@ -256,6 +336,13 @@ is set to MANUAL_ONLY.`,
 				DiffSuppressFunc: compareSelfLinkOrResourceName,
 				Description:      `Region where the router and NAT reside.`,
 			},
+			"rules": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: `A list of rules associated with this NAT.`,
+				Elem:        computeRouterNatRulesSchema(),
+				Set:         computeRouterNatRulesHash,
+			},
 			"subnetwork": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -278,6 +365,17 @@ Defaults to 1200s if not set.`,
 Defaults to 30s if not set.`,
 				Default: 30,
 			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateEnum([]string{"PUBLIC", "PRIVATE", ""}),
+				Description: `Indicates whether this NAT is used for public or private IP translation.
+If unspecified, it defaults to PUBLIC.
+If 'PUBLIC' NAT used for public IP translation.
+If 'PRIVATE' NAT used for private IP translation. Default value: "PUBLIC" Possible values: ["PUBLIC", "PRIVATE"]`,
+				Default: "PUBLIC",
+			},
 			"udp_idle_timeout_sec": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -333,6 +431,100 @@ sourceIpRangesToNat`,
 	}
 }
 
+func computeRouterNatRulesSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"match": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: `CEL expression that specifies the match condition that egress traffic from a VM is evaluated against.
+If it evaluates to true, the corresponding action is enforced.
+
+The following examples are valid match expressions for public NAT:
+
+"inIpRange(destination.ip, '1.1.0.0/16') || inIpRange(destination.ip, '2.2.0.0/16')"
+
+"destination.ip == '1.1.0.1' || destination.ip == '8.8.8.8'"
+
+The following example is a valid match expression for private NAT:
+
+"nexthop.hub == '//networkconnectivity.googleapis.com/projects/my-project/locations/global/hubs/hub-1'"`,
+			},
+			"rule_number": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 65000),
+				Description: `An integer uniquely identifying a rule in the list.
+The rule number must be a positive value between 0 and 65000, and must be unique among rules within a NAT.`,
+			},
+			"action": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `The action to be enforced for traffic that matches this rule.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_nat_active_ips": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Description: `A list of URLs of the IP resources used for this NAT rule.
+These IP addresses must be valid static external IP addresses assigned to the project.
+This field is used for public NAT.`,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								DiffSuppressFunc: compareSelfLinkOrResourceName,
+							},
+							Set: computeRouterNatIPsHash,
+						},
+						"source_nat_active_ranges": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Description: `A list of URLs of the subnetworks used as source ranges for this NAT Rule.
+These subnetworks must have purpose set to PRIVATE_NAT.
+This field is used for private NAT.`,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								DiffSuppressFunc: compareSelfLinkOrResourceName,
+							},
+							Set: computeRouterNatIPsHash,
+						},
+						"source_nat_drain_ips": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Description: `A list of URLs of the IP resources to be drained.
+These IPs must be valid static external IPs that have been assigned to the NAT.
+These IPs should be used for updating/patching a NAT rule only.
+This field is used for public NAT.`,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								DiffSuppressFunc: compareSelfLinkOrResourceName,
+							},
+							Set: computeRouterNatIPsHash,
+						},
+						"source_nat_drain_ranges": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Description: `A list of URLs of subnetworks representing source ranges to be drained.
+This is only supported on patch/update, and these subnetworks must have previously been used as active ranges in this NAT Rule.
+This field is used for private NAT.`,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								DiffSuppressFunc: compareSelfLinkOrResourceName,
+							},
+							Set: computeRouterNatIPsHash,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `An optional description of this rule.`,
+			},
+		},
+	}
+}
+
 func resourceComputeRouterNatCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	userAgent, err := generateUserAgentString(d, config.userAgent)
@@ -365,6 +557,13 @@ func resourceComputeRouterNatCreate(d *schema.ResourceData, meta interface{}) er
 	} else if v, ok := d.GetOkExists("drain_nat_ips"); ok || !reflect.DeepEqual(v, drainNatIpsProp) {
 		obj["drainNatIps"] = drainNatIpsProp
 	}
+	if v, ok := d.GetOk("initial_nat_ips"); ok {
+		initialNatIpsProp, err := expandNestedComputeRouterNatNatIps(v, d, config)
+		if err != nil {
+			return err
+		}
+		obj["natIps"] = initialNatIpsProp
+	}
 	sourceSubnetworkIpRangesToNatProp, err := expandNestedComputeRouterNatSourceSubnetworkIpRangesToNat(d.Get("source_subnetwork_ip_ranges_to_nat"), d, config)
 	if err != nil {
 		return err
@@ -431,6 +630,18 @@ func resourceComputeRouterNatCreate(d *schema.ResourceData, meta interface{}) er
 	} else if v, ok := d.GetOkExists("enable_endpoint_independent_mapping"); ok || !reflect.DeepEqual(v, enableEndpointIndependentMappingProp) {
 		obj["enableEndpointIndependentMapping"] = enableEndpointIndependentMappingProp
 	}
+	typeProp, err := expandNestedComputeRouterNatType(d.Get("type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("type"); !isEmptyValue(reflect.ValueOf(typeProp)) && (ok || !reflect.DeepEqual(v, typeProp)) {
+		obj["type"] = typeProp
+	}
+	rulesProp, err := expandNestedComputeRouterNatRules(d.Get("rules"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("rules"); ok || !reflect.DeepEqual(v, rulesProp) {
+		obj["rules"] = rulesProp
+	}
 
 	lockName, err := replaceVars(d, config, "router/{{region}}/{{router}}")
 	if err != nil {
@@ -581,6 +792,12 @@ func resourceComputeRouterNatRead(d *schema.ResourceData, meta interface{}) erro
 	if err := d.Set("enable_endpoint_independent_mapping", flattenNestedComputeRouterNatEnableEndpointIndependentMapping(res["enableEndpointIndependentMapping"], d, config)); err != nil {
 		return fmt.Errorf("Error reading RouterNat: %s", err)
 	}
+	if err := d.Set("type", flattenNestedComputeRouterNatType(res["type"], d, config)); err != nil {
+		return fmt.Errorf("Error reading RouterNat: %s", err)
+	}
+	if err := d.Set("rules", flattenNestedComputeRouterNatRules(res["rules"], d, config)); err != nil {
+		return fmt.Errorf("Error reading RouterNat: %s", err)
+	}
 
 	return nil
 }
@@ -619,6 +836,12 @@ func resourceComputeRouterNatUpdate(d *schema.ResourceData, meta interface{}) er
 	} else if v, ok := d.GetOkExists("drain_nat_ips"); ok || !reflect.DeepEqual(v, drainNatIpsProp) {
 		obj["drainNatIps"] = drainNatIpsProp
 	}
+	// Keep the addresses set by google_compute_router_nat_address, which the
+	// update encoder reads from the router.
+	if routerNatIpsManagedByNatAddress("", "", "", d) {
+		delete(obj, "natIps")
+		delete(obj, "drainNatIps")
+	}
 	sourceSubnetworkIpRangesToNatProp, err := expandNestedComputeRouterNatSourceSubnetworkIpRangesToNat(d.Get("source_subnetwork_ip_ranges_to_nat"), d, config)
 	if err != nil {
 		return err
@@ -685,6 +908,12 @@ func resourceComputeRouterNatUpdate(d *schema.ResourceData, meta interface{}) er
 	} else if v, ok := d.GetOkExists("enable_endpoint_independent_mapping"); ok || !reflect.DeepEqual(v, enableEndpointIndependentMappingProp) {
 		obj["enableEndpointIndependentMapping"] = enableEndpointIndependentMappingProp
 	}
+	rulesProp, err := expandNestedComputeRouterNatRules(d.Get("rules"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("rules"); ok || !reflect.DeepEqual(v, rulesProp) {
+		obj["rules"] = rulesProp
+	}
 
 	lockName, err := replaceVars(d, config, "router/{{region}}/{{router}}")
 	if err != nil {
@@ -995,6 +1224,107 @@ func flattenNestedComputeRouterNatEnableEndpointIndependentMapping(v interface{}
 	return v
 }
 
+func flattenNestedComputeRouterNatType(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	if v == nil || isEmptyValue(reflect.ValueOf(v)) {
+		return "PUBLIC"
+	}
+
+	return v
+}
+
+func flattenNestedComputeRouterNatRules(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := schema.NewSet(computeRouterNatRulesHash, []interface{}{})
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed.Add(map[string]interface{}{
+			"rule_number": flattenNestedComputeRouterNatRulesRuleNumber(original["ruleNumber"], d, config),
+			"description": flattenNestedComputeRouterNatRulesDescription(original["description"], d, config),
+			"match":       flattenNestedComputeRouterNatRulesMatch(original["match"], d, config),
+			"action":      flattenNestedComputeRouterNatRulesAction(original["action"], d, config),
+		})
+	}
+	return transformed
+}
+func flattenNestedComputeRouterNatRulesRuleNumber(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := stringToFixed64(strVal); err == nil {
+			return intVal
+		}
+	}
+
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+
+	return v // let terraform core handle it otherwise
+}
+
+func flattenNestedComputeRouterNatRulesDescription(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	return v
+}
+
+func flattenNestedComputeRouterNatRulesMatch(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	return v
+}
+
+func flattenNestedComputeRouterNatRulesAction(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["source_nat_active_ips"] =
+		flattenNestedComputeRouterNatRulesActionSourceNatActiveIps(original["sourceNatActiveIps"], d, config)
+	transformed["source_nat_drain_ips"] =
+		flattenNestedComputeRouterNatRulesActionSourceNatDrainIps(original["sourceNatDrainIps"], d, config)
+	transformed["source_nat_active_ranges"] =
+		flattenNestedComputeRouterNatRulesActionSourceNatActiveRanges(original["sourceNatActiveRanges"], d, config)
+	transformed["source_nat_drain_ranges"] =
+		flattenNestedComputeRouterNatRulesActionSourceNatDrainRanges(original["sourceNatDrainRanges"], d, config)
+	return []interface{}{transformed}
+}
+func flattenNestedComputeRouterNatRulesActionSourceNatActiveIps(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	if v == nil {
+		return v
+	}
+	return schema.NewSet(computeRouterNatIPsHash, convertStringArrToInterface(convertAndMapStringArr(v.([]interface{}), ConvertSelfLinkToV1)))
+}
+
+func flattenNestedComputeRouterNatRulesActionSourceNatDrainIps(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	if v == nil {
+		return v
+	}
+	return schema.NewSet(computeRouterNatIPsHash, convertStringArrToInterface(convertAndMapStringArr(v.([]interface{}), ConvertSelfLinkToV1)))
+}
+
+func flattenNestedComputeRouterNatRulesActionSourceNatActiveRanges(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	if v == nil {
+		return v
+	}
+	return schema.NewSet(computeRouterNatIPsHash, convertStringArrToInterface(convertAndMapStringArr(v.([]interface{}), ConvertSelfLinkToV1)))
+}
+
+func flattenNestedComputeRouterNatRulesActionSourceNatDrainRanges(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	if v == nil {
+		return v
+	}
+	return schema.NewSet(computeRouterNatIPsHash, convertStringArrToInterface(convertAndMapStringArr(v.([]interface{}), ConvertSelfLinkToV1)))
+}
+
 func expandNestedComputeRouterNatName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
@@ -1162,6 +1492,174 @@ func expandNestedComputeRouterNatEnableEndpointIndependentMapping(v interface{},
 	return v, nil
 }
 
+func expandNestedComputeRouterNatType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNestedComputeRouterNatRules(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	v = v.(*schema.Set).List()
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedRuleNumber, err := expandNestedComputeRouterNatRulesRuleNumber(original["rule_number"], d, config)
+		if err != nil {
+			return nil, err
+		} else {
+			transformed["ruleNumber"] = transformedRuleNumber
+		}
+
+		transformedDescription, err := expandNestedComputeRouterNatRulesDescription(original["description"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedDescription); val.IsValid() && !isEmptyValue(val) {
+			transformed["description"] = transformedDescription
+		}
+
+		transformedMatch, err := expandNestedComputeRouterNatRulesMatch(original["match"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedMatch); val.IsValid() && !isEmptyValue(val) {
+			transformed["match"] = transformedMatch
+		}
+
+		transformedAction, err := expandNestedComputeRouterNatRulesAction(original["action"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAction); val.IsValid() && !isEmptyValue(val) {
+			transformed["action"] = transformedAction
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandNestedComputeRouterNatRulesRuleNumber(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNestedComputeRouterNatRulesDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNestedComputeRouterNatRulesMatch(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNestedComputeRouterNatRulesAction(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedSourceNatActiveIps, err := expandNestedComputeRouterNatRulesActionSourceNatActiveIps(original["source_nat_active_ips"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSourceNatActiveIps); val.IsValid() && !isEmptyValue(val) {
+		transformed["sourceNatActiveIps"] = transformedSourceNatActiveIps
+	}
+
+	transformedSourceNatDrainIps, err := expandNestedComputeRouterNatRulesActionSourceNatDrainIps(original["source_nat_drain_ips"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSourceNatDrainIps); val.IsValid() && !isEmptyValue(val) {
+		transformed["sourceNatDrainIps"] = transformedSourceNatDrainIps
+	}
+
+	transformedSourceNatActiveRanges, err := expandNestedComputeRouterNatRulesActionSourceNatActiveRanges(original["source_nat_active_ranges"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSourceNatActiveRanges); val.IsValid() && !isEmptyValue(val) {
+		transformed["sourceNatActiveRanges"] = transformedSourceNatActiveRanges
+	}
+
+	transformedSourceNatDrainRanges, err := expandNestedComputeRouterNatRulesActionSourceNatDrainRanges(original["source_nat_drain_ranges"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSourceNatDrainRanges); val.IsValid() && !isEmptyValue(val) {
+		transformed["sourceNatDrainRanges"] = transformedSourceNatDrainRanges
+	}
+
+	return transformed, nil
+}
+
+func expandNestedComputeRouterNatRulesActionSourceNatActiveIps(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	v = v.(*schema.Set).List()
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			return nil, fmt.Errorf("Invalid value for source_nat_active_ips: nil")
+		}
+		f, err := parseRegionalFieldValue("addresses", raw.(string), "project", "region", "zone", d, config, true)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for source_nat_active_ips: %s", err)
+		}
+		req = append(req, f.RelativeLink())
+	}
+	return req, nil
+}
+
+func expandNestedComputeRouterNatRulesActionSourceNatDrainIps(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	v = v.(*schema.Set).List()
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			return nil, fmt.Errorf("Invalid value for source_nat_drain_ips: nil")
+		}
+		f, err := parseRegionalFieldValue("addresses", raw.(string), "project", "region", "zone", d, config, true)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for source_nat_drain_ips: %s", err)
+		}
+		req = append(req, f.RelativeLink())
+	}
+	return req, nil
+}
+
+func expandNestedComputeRouterNatRulesActionSourceNatActiveRanges(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	v = v.(*schema.Set).List()
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			return nil, fmt.Errorf("Invalid value for source_nat_active_ranges: nil")
+		}
+		f, err := parseRegionalFieldValue("subnetworks", raw.(string), "project", "region", "zone", d, config, true)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for source_nat_active_ranges: %s", err)
+		}
+		req = append(req, f.RelativeLink())
+	}
+	return req, nil
+}
+
+func expandNestedComputeRouterNatRulesActionSourceNatDrainRanges(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	v = v.(*schema.Set).List()
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			return nil, fmt.Errorf("Invalid value for source_nat_drain_ranges: nil")
+		}
+		f, err := parseRegionalFieldValue("subnetworks", raw.(string), "project", "region", "zone", d, config, true)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for source_nat_drain_ranges: %s", err)
+		}
+		req = append(req, f.RelativeLink())
+	}
+	return req, nil
+}
+
 func flattenNestedComputeRouterNat(d *schema.ResourceData, meta interface{}, res map[string]interface{}) (map[string]interface{}, error) {
 	var v interface{}
 	var ok bool
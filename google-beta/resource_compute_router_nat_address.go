@@ -0,0 +1,311 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceComputeRouterNatAddress() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeRouterNatAddressCreate,
+		Read:   resourceComputeRouterNatAddressRead,
+		Update: resourceComputeRouterNatAddressUpdate,
+		Delete: resourceComputeRouterNatAddressDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeRouterNatAddressImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		// drain_nat_ips has the same constraints as on google_compute_router_nat
+		CustomizeDiff: resourceComputeRouterNatDrainNatIpsCustomDiff,
+
+		Schema: map[string]*schema.Schema{
+			"router": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+				Description:      `The name of the Cloud Router in which the referenced NAT service is configured.`,
+			},
+			"router_nat": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The name of the Nat service in which this address will be configured.`,
+			},
+			"nat_ips": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Description: `Self-links of NAT IPs to be used in a Nat service. Only valid if the referenced RouterNat
+natIpAllocateOption is set to MANUAL_ONLY.`,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					DiffSuppressFunc: compareSelfLinkOrResourceName,
+				},
+				Set: computeRouterNatIPsHash,
+			},
+			"drain_nat_ips": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Description: `A list of URLs of the IP resources to be drained. These IPs must be
+valid static external IPs that have been assigned to the NAT.`,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					DiffSuppressFunc: compareSelfLinkOrResourceName,
+				},
+				Set: computeRouterNatIPsHash,
+			},
+			"region": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+				Description:      `Region where the NAT service resides.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceComputeRouterNatAddressCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := replaceVars(d, config, "projects/{{project}}/regions/{{region}}/routers/{{router}}/{{router_nat}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+
+	if err := resourceComputeRouterNatAddressPatch(d, meta, "Creating", d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	return resourceComputeRouterNatAddressRead(d, meta)
+}
+
+func resourceComputeRouterNatAddressRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/routers/{{router}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, userAgent, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeRouterNatAddress %q", d.Id()))
+	}
+
+	_, nat, err := resourceComputeRouterNatAddressFindNat(d, res)
+	if err != nil {
+		return err
+	}
+	if nat == nil {
+		log.Printf("[WARN] Removing router NAT address %s because its router NAT is gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("nat_ips", flattenNestedComputeRouterNatNatIps(nat["natIps"], d, config)); err != nil {
+		return fmt.Errorf("Error reading RouterNatAddress: %s", err)
+	}
+	if err := d.Set("drain_nat_ips", flattenNestedComputeRouterNatDrainNatIps(nat["drainNatIps"], d, config)); err != nil {
+		return fmt.Errorf("Error reading RouterNatAddress: %s", err)
+	}
+	if err := d.Set("region", region); err != nil {
+		return fmt.Errorf("Error reading RouterNatAddress: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading RouterNatAddress: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeRouterNatAddressUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceComputeRouterNatAddressPatch(d, meta, "Updating", d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceComputeRouterNatAddressRead(d, meta)
+}
+
+func resourceComputeRouterNatAddressDelete(d *schema.ResourceData, meta interface{}) error {
+	// Removing the addresses from the NAT is done by patching it with empty
+	// lists. A MANUAL_ONLY NAT must keep at least one NAT IP though, so only its
+	// drain_nat_ips are cleared, see resourceComputeRouterNatAddressPatch.
+	if err := d.Set("nat_ips", nil); err != nil {
+		return fmt.Errorf("Error clearing nat_ips: %s", err)
+	}
+	if err := d.Set("drain_nat_ips", nil); err != nil {
+		return fmt.Errorf("Error clearing drain_nat_ips: %s", err)
+	}
+
+	return resourceComputeRouterNatAddressPatch(d, meta, "Deleting", d.Timeout(schema.TimeoutDelete))
+}
+
+// resourceComputeRouterNatAddressPatch replaces the natIps and drainNatIps of
+// the referenced NAT, keeping everything else on the router as is. When
+// deleting, the natIps of a MANUAL_ONLY NAT are kept, as the API rejects such a
+// NAT without any NAT IP.
+func resourceComputeRouterNatAddressPatch(d *schema.ResourceData, meta interface{}, activity string, timeout time.Duration) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+	routerName := d.Get("router").(string)
+	natName := d.Get("router_nat").(string)
+
+	natIps, err := expandNestedComputeRouterNatNatIps(d.Get("nat_ips"), d, config)
+	if err != nil {
+		return err
+	}
+	drainNatIps, err := expandNestedComputeRouterNatDrainNatIps(d.Get("drain_nat_ips"), d, config)
+	if err != nil {
+		return err
+	}
+
+	routerLock := getRouterLockName(region, routerName)
+	mutexKV.Lock(routerLock)
+	defer mutexKV.Unlock(routerLock)
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/routers/{{router}}")
+	if err != nil {
+		return err
+	}
+
+	billingProject := project
+	// err == nil indicates that the billing_project value was found
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	res, err := sendRequest(config, "GET", billingProject, url, userAgent, nil)
+	if err != nil {
+		if activity == "Deleting" {
+			return handleNotFoundError(err, d, "RouterNatAddress")
+		}
+		return fmt.Errorf("Error reading router %s/%s: %s", region, routerName, err)
+	}
+
+	nats, nat, err := resourceComputeRouterNatAddressFindNat(d, res)
+	if err != nil {
+		return err
+	}
+	if nat == nil {
+		if activity == "Deleting" {
+			log.Printf("[DEBUG] Router NAT %s/%s/%s is gone, nothing to delete", region, routerName, natName)
+			return nil
+		}
+		return fmt.Errorf("Router NAT %s was not found in router %s/%s", natName, region, routerName)
+	}
+	if activity == "Deleting" && nat["natIpAllocateOption"] == "MANUAL_ONLY" {
+		log.Printf("[DEBUG] Keeping the NAT IPs of MANUAL_ONLY router NAT %s/%s/%s", region, routerName, natName)
+	} else {
+		nat["natIps"] = natIps
+	}
+	nat["drainNatIps"] = drainNatIps
+
+	obj := map[string]interface{}{
+		"nats": nats,
+	}
+
+	log.Printf("[DEBUG] %s addresses of router NAT %s/%s/%s: %#v", activity, region, routerName, natName, nat)
+	op, err := sendRequestWithTimeout(config, "PATCH", billingProject, url, userAgent, obj, timeout)
+	if err != nil {
+		return fmt.Errorf("Error patching router %s/%s: %s", region, routerName, err)
+	}
+
+	err = computeOperationWaitTime(config, op, project, fmt.Sprintf("%s RouterNatAddress", activity), userAgent, timeout)
+	if err != nil {
+		return fmt.Errorf("Error waiting to patch router %s/%s: %s", region, routerName, err)
+	}
+
+	return nil
+}
+
+// resourceComputeRouterNatAddressFindNat returns the nats of the router in res,
+// along with the one referenced by router_nat, or nil if it doesn't exist.
+func resourceComputeRouterNatAddressFindNat(d *schema.ResourceData, res map[string]interface{}) ([]interface{}, map[string]interface{}, error) {
+	v, ok := res["nats"]
+	if !ok || v == nil {
+		return nil, nil, nil
+	}
+	nats, ok := v.([]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf(`expected list for nested field "nats"`)
+	}
+
+	natName := d.Get("router_nat").(string)
+	for _, raw := range nats {
+		nat, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if nat["name"] == natName {
+			return nats, nat, nil
+		}
+	}
+	return nats, nil, nil
+}
+
+func resourceComputeRouterNatAddressImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/routers/(?P<router>[^/]+)/(?P<router_nat>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<router>[^/]+)/(?P<router_nat>[^/]+)",
+		"(?P<region>[^/]+)/(?P<router>[^/]+)/(?P<router_nat>[^/]+)",
+		"(?P<router>[^/]+)/(?P<router_nat>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/regions/{{region}}/routers/{{router}}/{{router_nat}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,222 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccComputeRouterNatAddress_withAddressCountUpdate(t *testing.T) {
+	t.Parallel()
+
+	project := getTestProjectFromEnv()
+	region := getTestRegionFromEnv()
+
+	testId := randString(t, 10)
+	routerName := fmt.Sprintf("tf-test-router-nat-addr-%s", testId)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRouterNatDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRouterNatAddressWithNatIps(routerName, 2),
+			},
+			{
+				ResourceName:      "google_compute_router_nat_address.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_compute_router_nat_address.foobar",
+				ImportStateId:     fmt.Sprintf("%s/%s/%s/%s", project, region, routerName, routerName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRouterNatAddressWithNatIps(routerName, 3),
+			},
+			{
+				ResourceName:      "google_compute_router_nat_address.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRouterNatAddressWithNatIps(routerName, 1),
+			},
+			{
+				ResourceName:      "google_compute_router_nat_address.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// initial_nat_ips is only used to create the NAT
+				ResourceName:            "google_compute_router_nat.foobar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"initial_nat_ips", "nat_ips"},
+			},
+			{
+				// The NAT itself is left in place, and keeps its addresses as a
+				// MANUAL_ONLY NAT can't be left without any
+				Config: testAccComputeRouterNatAddressKeepNat(routerName),
+				Check:  testAccCheckComputeRouterNatAddressDelete(t, "google_compute_router_nat.foobar"),
+			},
+		},
+	})
+}
+
+func TestAccComputeRouterNatAddress_withDrainNatIps(t *testing.T) {
+	t.Parallel()
+
+	testId := randString(t, 10)
+	routerName := fmt.Sprintf("tf-test-router-nat-addr-%s", testId)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRouterNatDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRouterNatAddressWithNatIps(routerName, 2),
+			},
+			// (ERROR) - Should not allow draining IPs still in nat_ips
+			{
+				Config:      testAccComputeRouterNatAddressWithDrainNatIps(routerName, 1, 1),
+				ExpectError: regexp.MustCompile("cannot be drained if still set in nat_ips"),
+			},
+			{
+				Config: testAccComputeRouterNatAddressWithDrainNatIps(routerName, 1, 0),
+			},
+			{
+				ResourceName:      "google_compute_router_nat_address.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeRouterNatAddressDelete(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := googleProviderConfig(t)
+
+		routersService := config.NewComputeClient(config.userAgent).Routers
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		project, err := getTestProject(rs.Primary, config)
+		if err != nil {
+			return err
+		}
+
+		region, err := getTestRegion(rs.Primary, config)
+		if err != nil {
+			return err
+		}
+
+		name := rs.Primary.Attributes["name"]
+		routerName := rs.Primary.Attributes["router"]
+
+		router, err := routersService.Get(project, region, routerName).Do()
+		if err != nil {
+			return fmt.Errorf("Error Reading Router %s: %s", routerName, err)
+		}
+
+		for _, nat := range router.Nats {
+			if nat.Name != name {
+				continue
+			}
+			if len(nat.NatIps) == 0 {
+				return fmt.Errorf("Nat %s on router %s/%s was left without addresses", name, region, router.Name)
+			}
+			if len(nat.DrainNatIps) > 0 {
+				return fmt.Errorf("Nat %s on router %s/%s still has drained addresses %v", name, region, router.Name, nat.DrainNatIps)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("Nat %s was not found on router %s/%s", name, region, router.Name)
+	}
+}
+
+func testAccComputeRouterNatAddressBaseResources(routerName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "foobar" {
+  name                    = "%s-net"
+  auto_create_subnetworks = "false"
+}
+
+resource "google_compute_subnetwork" "foobar" {
+  name          = "%s-subnet"
+  network       = google_compute_network.foobar.self_link
+  ip_cidr_range = "10.0.0.0/16"
+  region        = "us-central1"
+}
+
+resource "google_compute_address" "addr" {
+  count  = 3
+  name   = "%s-addr${count.index}"
+  region = google_compute_subnetwork.foobar.region
+}
+
+resource "google_compute_router" "foobar" {
+  name    = "%s"
+  region  = google_compute_subnetwork.foobar.region
+  network = google_compute_network.foobar.self_link
+}
+
+resource "google_compute_router_nat" "foobar" {
+  name     = "%s"
+  router   = google_compute_router.foobar.name
+  region   = google_compute_router.foobar.region
+
+  nat_ip_allocate_option = "MANUAL_ONLY"
+  initial_nat_ips        = [google_compute_address.addr[0].self_link]
+
+  source_subnetwork_ip_ranges_to_nat = "LIST_OF_SUBNETWORKS"
+  subnetwork {
+    name                    = google_compute_subnetwork.foobar.self_link
+    source_ip_ranges_to_nat = ["ALL_IP_RANGES"]
+  }
+}
+`, routerName, routerName, routerName, routerName, routerName)
+}
+
+func testAccComputeRouterNatAddressWithNatIps(routerName string, addressCount int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "google_compute_router_nat_address" "foobar" {
+  nat_ips    = slice(google_compute_address.addr.*.self_link, 0, %d)
+  router     = google_compute_router.foobar.name
+  router_nat = google_compute_router_nat.foobar.name
+  region     = google_compute_router_nat.foobar.region
+}
+`, testAccComputeRouterNatAddressBaseResources(routerName), addressCount)
+}
+
+func testAccComputeRouterNatAddressWithDrainNatIps(routerName string, natIp, drainNatIp int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "google_compute_router_nat_address" "foobar" {
+  nat_ips       = [google_compute_address.addr[%d].self_link]
+  drain_nat_ips = [google_compute_address.addr[%d].self_link]
+  router        = google_compute_router.foobar.name
+  router_nat    = google_compute_router_nat.foobar.name
+  region        = google_compute_router_nat.foobar.region
+}
+`, testAccComputeRouterNatAddressBaseResources(routerName), natIp, drainNatIp)
+}
+
+func testAccComputeRouterNatAddressKeepNat(routerName string) string {
+	return testAccComputeRouterNatAddressBaseResources(routerName)
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -271,6 +272,113 @@ func TestAccComputeRouterNat_withNatIpsAndDrainNatIps(t *testing.T) {
 	})
 }
 
+func TestComputeRouterNatRulesHash(t *testing.T) {
+	t.Parallel()
+
+	// A rule as read from config
+	configured := map[string]interface{}{
+		"rule_number": 100,
+		"description": "nat rules example",
+		"match":       "destination.ip == '8.8.8.8'",
+		"action": []interface{}{
+			map[string]interface{}{
+				"source_nat_active_ips": schema.NewSet(computeRouterNatIPsHash, []interface{}{"my-address"}),
+				"source_nat_drain_ips":  schema.NewSet(computeRouterNatIPsHash, nil),
+			},
+		},
+	}
+
+	// The same rule as returned by the API
+	flattened := flattenNestedComputeRouterNatRules([]interface{}{
+		map[string]interface{}{
+			"ruleNumber":  float64(100),
+			"description": "nat rules example",
+			"match":       "destination.ip == '8.8.8.8'",
+			"action": map[string]interface{}{
+				"sourceNatActiveIps": []interface{}{"https://www.googleapis.com/compute/beta/projects/my-project/regions/us-central1/addresses/my-address"},
+			},
+		},
+	}, nil, nil).(*schema.Set)
+
+	if flattened.Len() != 1 {
+		t.Fatalf("expected 1 flattened rule, got %d", flattened.Len())
+	}
+	if computeRouterNatRulesHash(configured) != computeRouterNatRulesHash(flattened.List()[0]) {
+		t.Errorf("expected configured and flattened rule to have the same hash, got %#v and %#v", configured, flattened.List()[0])
+	}
+
+	configured["match"] = "destination.ip == '1.1.1.1'"
+	if computeRouterNatRulesHash(configured) == computeRouterNatRulesHash(flattened.List()[0]) {
+		t.Errorf("expected rules with a different match to have different hashes")
+	}
+}
+
+func TestAccComputeRouterNat_withNatRules(t *testing.T) {
+	t.Parallel()
+
+	testId := randString(t, 10)
+	routerName := fmt.Sprintf("tf-test-router-nat-%s", testId)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRouterNatDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRouterNatWithRules(routerName, "inIpRange(destination.ip, '1.1.0.0/16') || inIpRange(destination.ip, '2.2.0.0/16')", "google_compute_address.addr2.self_link", ""),
+			},
+			{
+				ResourceName:      "google_compute_router_nat.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRouterNatWithRules(routerName, "destination.ip == '1.1.0.1' || destination.ip == '8.8.8.8'", "google_compute_address.addr3.self_link", "google_compute_address.addr2.self_link"),
+			},
+			{
+				ResourceName:      "google_compute_router_nat.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRouterNatWithNatIps(routerName),
+			},
+			{
+				ResourceName:      "google_compute_router_nat.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeRouterNat_withPrivateNat(t *testing.T) {
+	t.Parallel()
+
+	testId := randString(t, 10)
+	routerName := fmt.Sprintf("tf-test-router-private-nat-%s", testId)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRouterNatDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComputeRouterNatPrivateType(routerName, `nat_ip_allocate_option = "AUTO_ONLY"`),
+				ExpectError: regexp.MustCompile("nat_ip_allocate_option cannot be set for a RouterNat of type PRIVATE"),
+			},
+			{
+				Config: testAccComputeRouterNatPrivateType(routerName, ""),
+			},
+			{
+				ResourceName:      "google_compute_router_nat.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeRouterNatDestroyProducer(t *testing.T) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
 		config := googleProviderConfig(t)
@@ -938,3 +1046,98 @@ resource "google_compute_router_nat" "foobar" {
 }
 `, routerName, routerName, routerName, routerName)
 }
+
+func testAccComputeRouterNatWithRules(routerName, match, activeIp, drainIp string) string {
+	drainIps := ""
+	if drainIp != "" {
+		drainIps = fmt.Sprintf("source_nat_drain_ips = [%s]", drainIp)
+	}
+	return fmt.Sprintf(`
+%s
+
+resource "google_compute_router_nat" "foobar" {
+  name     = "%s"
+  router   = google_compute_router.foobar.name
+  region   = google_compute_router.foobar.region
+
+  nat_ip_allocate_option = "MANUAL_ONLY"
+  nat_ips = [
+    google_compute_address.addr1.self_link,
+  ]
+
+  source_subnetwork_ip_ranges_to_nat = "LIST_OF_SUBNETWORKS"
+  subnetwork {
+    name                    = google_compute_subnetwork.foobar.self_link
+    source_ip_ranges_to_nat = ["ALL_IP_RANGES"]
+  }
+
+  rules {
+    rule_number = 100
+    description = "nat rules example"
+    match       = "%s"
+    action {
+      source_nat_active_ips = [%s]
+      %s
+    }
+  }
+
+  enable_endpoint_independent_mapping = false
+}
+`, testAccComputeRouterNatBaseResourcesWithNatIps(routerName), routerName, match, activeIp, drainIps)
+}
+
+func testAccComputeRouterNatPrivateType(routerName, extra string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "foobar" {
+  name                    = "%s-net"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "foobar" {
+  name          = "%s-subnet"
+  network       = google_compute_network.foobar.self_link
+  ip_cidr_range = "10.0.0.0/16"
+  region        = "us-central1"
+  purpose       = "PRIVATE_NAT"
+}
+
+resource "google_compute_router" "foobar" {
+  name    = "%s"
+  region  = google_compute_subnetwork.foobar.region
+  network = google_compute_network.foobar.self_link
+}
+
+resource "google_network_connectivity_hub" "foobar" {
+  name        = "%s-hub"
+  description = "vpc hub for inter vpc nat"
+}
+
+resource "google_compute_router_nat" "foobar" {
+  name                                = "%s"
+  router                              = google_compute_router.foobar.name
+  region                              = google_compute_router.foobar.region
+  type                                = "PRIVATE"
+  enable_dynamic_port_allocation      = false
+  enable_endpoint_independent_mapping = false
+  min_ports_per_vm                    = 32
+  %s
+
+  source_subnetwork_ip_ranges_to_nat = "LIST_OF_SUBNETWORKS"
+  subnetwork {
+    name                    = google_compute_subnetwork.foobar.id
+    source_ip_ranges_to_nat = ["ALL_IP_RANGES"]
+  }
+
+  rules {
+    rule_number = 100
+    description = "rule for private nat"
+    match       = "nexthop.hub == \"//networkconnectivity.googleapis.com/projects/${google_compute_router.foobar.project}/locations/global/hubs/${google_network_connectivity_hub.foobar.name}\""
+    action {
+      source_nat_active_ranges = [
+        google_compute_subnetwork.foobar.self_link
+      ]
+    }
+  }
+}
+`, routerName, routerName, routerName, routerName, routerName, extra)
+}
@@ -75,6 +75,29 @@ func TestAccComputeSecurityPolicy_withRuleExpr(t *testing.T) {
 	})
 }
 
+func TestAccComputeSecurityPolicy_withRuleExprAddressGroup(t *testing.T) {
+	t.Parallel()
+
+	spName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	agName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeSecurityPolicyDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeSecurityPolicy_withRuleExprAddressGroup(spName, agName, getTestProjectFromEnv()),
+			},
+			{
+				ResourceName:      "google_compute_security_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccComputeSecurityPolicy_update(t *testing.T) {
 	t.Parallel()
 
@@ -415,6 +438,47 @@ resource "google_compute_security_policy" "policy" {
 `, spName)
 }
 
+func testAccComputeSecurityPolicy_withRuleExprAddressGroup(spName, agName, project string) string {
+	return fmt.Sprintf(`
+resource "google_network_security_address_group" "partners" {
+  name     = "%s"
+  parent   = "projects/%s"
+  location = "global"
+  type     = "IPV4"
+  capacity = 100
+  purpose  = ["CLOUD_ARMOR"]
+  items    = ["208.80.154.224/32", "198.51.100.0/24"]
+}
+
+resource "google_compute_security_policy" "policy" {
+  name = "%s"
+
+  rule {
+    action   = "allow"
+    priority = "2147483647"
+    match {
+      versioned_expr = "SRC_IPS_V1"
+      config {
+        src_ip_ranges = ["*"]
+      }
+    }
+    description = "default rule"
+  }
+
+  rule {
+    action   = "deny(403)"
+    priority = "1000"
+    match {
+      expr {
+        expression = "evaluateAddressGroup('${google_network_security_address_group.partners.name}', origin.ip)"
+      }
+    }
+    description = "deny the addresses of an address group"
+  }
+}
+`, agName, project, spName)
+}
+
 func testAccComputeSecurityPolicy_withAdvancedOptionsConfig(spName string) string {
 	return fmt.Sprintf(`
 resource "google_compute_security_policy" "policy" {
@@ -67,6 +67,7 @@ func resourceComputeSubnetwork() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.ForceNewIfChange("ip_cidr_range", isShrinkageIpCidr),
 			resourceComputeSubnetworkSecondaryIpRangeSetStyleDiff,
+			resourceComputeSubnetworkCidrOverlapCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
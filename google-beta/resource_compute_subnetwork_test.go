@@ -3,6 +3,7 @@ package google
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -88,6 +89,38 @@ func TestAccComputeSubnetwork_basic(t *testing.T) {
 	})
 }
 
+func TestAccComputeSubnetwork_cidrOverlap(t *testing.T) {
+	t.Parallel()
+
+	cnName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	subnetworkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	overlappingName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeSubnetworkDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeSubnetwork_cidrOverlap(cnName, subnetworkName, "", ""),
+			},
+			{
+				Config:      testAccComputeSubnetwork_cidrOverlap(cnName, subnetworkName, overlappingName, "10.0.128.0/20"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`ip_cidr_range "10.0.128.0/20" overlaps with the range already used by subnetwork ".*/subnetworks/%s"`, subnetworkName)),
+			},
+			{
+				Config:      testAccComputeSubnetwork_cidrOverlap(cnName, subnetworkName, overlappingName, "10.4.0.0/16"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`secondary_ip_range "pods"`),
+			},
+			{
+				Config: testAccComputeSubnetwork_cidrOverlap(cnName, subnetworkName, overlappingName, "10.1.0.0/16"),
+			},
+		},
+	})
+}
+
 func TestAccComputeSubnetwork_update(t *testing.T) {
 	t.Parallel()
 
@@ -391,6 +424,43 @@ func testAccCheckComputeSubnetworkHasNotSecondaryIpRange(subnetwork *compute.Sub
 	}
 }
 
+func testAccComputeSubnetwork_cidrOverlap(cnName, subnetworkName, otherName, otherCidr string) string {
+	config := fmt.Sprintf(`
+provider "google" {
+  cidr_overlap_check = true
+}
+
+resource "google_compute_network" "custom-test" {
+  name                    = "%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "network-ref-by-url" {
+  name          = "%s"
+  ip_cidr_range = "10.0.0.0/16"
+  region        = "us-central1"
+  network       = google_compute_network.custom-test.self_link
+
+  secondary_ip_range {
+    range_name    = "pods"
+    ip_cidr_range = "10.4.0.0/14"
+  }
+}
+`, cnName, subnetworkName)
+
+	if otherName == "" {
+		return config
+	}
+	return config + fmt.Sprintf(`
+resource "google_compute_subnetwork" "other" {
+  name          = "%s"
+  ip_cidr_range = "%s"
+  region        = "us-central1"
+  network       = google_compute_network.custom-test.self_link
+}
+`, otherName, otherCidr)
+}
+
 func testAccComputeSubnetwork_basic(cnName, subnetwork1Name, subnetwork2Name, subnetwork3Name string) string {
 	return fmt.Sprintf(`
 resource "google_compute_network" "custom-test" {
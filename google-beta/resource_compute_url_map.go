@@ -15,6 +15,7 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
@@ -25,6 +26,103 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+func resourceComputeUrlMapValidateOnPlanCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("validate_on_plan").(bool) {
+		return nil
+	}
+	// Nothing to validate if an existing url map has no pending changes.
+	if diff.Id() != "" && len(diff.GetChangedKeysPrefix("")) == 0 {
+		return nil
+	}
+	// Referenced backends that are not created yet can't be validated against.
+	if !urlMapConfigWhollyKnown(diff) {
+		return nil
+	}
+
+	config := meta.(*Config)
+	d := resourceDiffData{diff}
+
+	obj, err := resourceComputeUrlMapExpand(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/urlMaps/{{name}}/validate")
+	if err != nil {
+		return err
+	}
+
+	return validateUrlMapOnPlan(config, d, url, obj)
+}
+
+// resourceComputeUrlMapExpand builds the request body sent to create the
+// url map, which is also validated on plan when validate_on_plan is set.
+func resourceComputeUrlMapExpand(d TerraformResourceData, config *Config) (map[string]interface{}, error) {
+	obj := make(map[string]interface{})
+	defaultServiceProp, err := expandComputeUrlMapDefaultService(d.Get("default_service"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("default_service"); !isEmptyValue(reflect.ValueOf(defaultServiceProp)) && (ok || !reflect.DeepEqual(v, defaultServiceProp)) {
+		obj["defaultService"] = defaultServiceProp
+	}
+	descriptionProp, err := expandComputeUrlMapDescription(d.Get("description"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	fingerprintProp, err := expandComputeUrlMapFingerprint(d.Get("fingerprint"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("fingerprint"); !isEmptyValue(reflect.ValueOf(fingerprintProp)) && (ok || !reflect.DeepEqual(v, fingerprintProp)) {
+		obj["fingerprint"] = fingerprintProp
+	}
+	headerActionProp, err := expandComputeUrlMapHeaderAction(d.Get("header_action"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("header_action"); !isEmptyValue(reflect.ValueOf(headerActionProp)) && (ok || !reflect.DeepEqual(v, headerActionProp)) {
+		obj["headerAction"] = headerActionProp
+	}
+	hostRulesProp, err := expandComputeUrlMapHostRule(d.Get("host_rule"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("host_rule"); !isEmptyValue(reflect.ValueOf(hostRulesProp)) && (ok || !reflect.DeepEqual(v, hostRulesProp)) {
+		obj["hostRules"] = hostRulesProp
+	}
+	nameProp, err := expandComputeUrlMapName(d.Get("name"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	pathMatchersProp, err := expandComputeUrlMapPathMatcher(d.Get("path_matcher"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("path_matcher"); !isEmptyValue(reflect.ValueOf(pathMatchersProp)) && (ok || !reflect.DeepEqual(v, pathMatchersProp)) {
+		obj["pathMatchers"] = pathMatchersProp
+	}
+	testsProp, err := expandComputeUrlMapTest(d.Get("test"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("test"); !isEmptyValue(reflect.ValueOf(testsProp)) && (ok || !reflect.DeepEqual(v, testsProp)) {
+		obj["tests"] = testsProp
+	}
+	defaultUrlRedirectProp, err := expandComputeUrlMapDefaultUrlRedirect(d.Get("default_url_redirect"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("default_url_redirect"); !isEmptyValue(reflect.ValueOf(defaultUrlRedirectProp)) && (ok || !reflect.DeepEqual(v, defaultUrlRedirectProp)) {
+		obj["defaultUrlRedirect"] = defaultUrlRedirectProp
+	}
+	defaultRouteActionProp, err := expandComputeUrlMapDefaultRouteAction(d.Get("default_route_action"), d, config)
+	if err != nil {
+		return nil, err
+	} else if v, ok := d.GetOkExists("default_route_action"); !isEmptyValue(reflect.ValueOf(defaultRouteActionProp)) && (ok || !reflect.DeepEqual(v, defaultRouteActionProp)) {
+		obj["defaultRouteAction"] = defaultRouteActionProp
+	}
+
+	return obj, nil
+}
+
 func resourceComputeUrlMap() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeUrlMapCreate,
@@ -42,6 +140,8 @@ func resourceComputeUrlMap() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: resourceComputeUrlMapValidateOnPlanCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -2712,6 +2812,13 @@ field is used in optimistic locking.`,
 				Computed:    true,
 				Description: `The unique identifier for the resource.`,
 			},
+			"validate_on_plan": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: `If set to true, the url map and its tests are validated with the urlMaps.validate API during plan,
+as long as all referenced backends are known. Load errors and failing tests are reported as plan errors.`,
+			},
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -2764,66 +2871,9 @@ func resourceComputeUrlMapCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	obj := make(map[string]interface{})
-	defaultServiceProp, err := expandComputeUrlMapDefaultService(d.Get("default_service"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("default_service"); !isEmptyValue(reflect.ValueOf(defaultServiceProp)) && (ok || !reflect.DeepEqual(v, defaultServiceProp)) {
-		obj["defaultService"] = defaultServiceProp
-	}
-	descriptionProp, err := expandComputeUrlMapDescription(d.Get("description"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
-		obj["description"] = descriptionProp
-	}
-	fingerprintProp, err := expandComputeUrlMapFingerprint(d.Get("fingerprint"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("fingerprint"); !isEmptyValue(reflect.ValueOf(fingerprintProp)) && (ok || !reflect.DeepEqual(v, fingerprintProp)) {
-		obj["fingerprint"] = fingerprintProp
-	}
-	headerActionProp, err := expandComputeUrlMapHeaderAction(d.Get("header_action"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("header_action"); !isEmptyValue(reflect.ValueOf(headerActionProp)) && (ok || !reflect.DeepEqual(v, headerActionProp)) {
-		obj["headerAction"] = headerActionProp
-	}
-	hostRulesProp, err := expandComputeUrlMapHostRule(d.Get("host_rule"), d, config)
+	obj, err := resourceComputeUrlMapExpand(d, config)
 	if err != nil {
 		return err
-	} else if v, ok := d.GetOkExists("host_rule"); !isEmptyValue(reflect.ValueOf(hostRulesProp)) && (ok || !reflect.DeepEqual(v, hostRulesProp)) {
-		obj["hostRules"] = hostRulesProp
-	}
-	nameProp, err := expandComputeUrlMapName(d.Get("name"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
-		obj["name"] = nameProp
-	}
-	pathMatchersProp, err := expandComputeUrlMapPathMatcher(d.Get("path_matcher"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("path_matcher"); !isEmptyValue(reflect.ValueOf(pathMatchersProp)) && (ok || !reflect.DeepEqual(v, pathMatchersProp)) {
-		obj["pathMatchers"] = pathMatchersProp
-	}
-	testsProp, err := expandComputeUrlMapTest(d.Get("test"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("test"); !isEmptyValue(reflect.ValueOf(testsProp)) && (ok || !reflect.DeepEqual(v, testsProp)) {
-		obj["tests"] = testsProp
-	}
-	defaultUrlRedirectProp, err := expandComputeUrlMapDefaultUrlRedirect(d.Get("default_url_redirect"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("default_url_redirect"); !isEmptyValue(reflect.ValueOf(defaultUrlRedirectProp)) && (ok || !reflect.DeepEqual(v, defaultUrlRedirectProp)) {
-		obj["defaultUrlRedirect"] = defaultUrlRedirectProp
-	}
-	defaultRouteActionProp, err := expandComputeUrlMapDefaultRouteAction(d.Get("default_route_action"), d, config)
-	if err != nil {
-		return err
-	} else if v, ok := d.GetOkExists("default_route_action"); !isEmptyValue(reflect.ValueOf(defaultRouteActionProp)) && (ok || !reflect.DeepEqual(v, defaultRouteActionProp)) {
-		obj["defaultRouteAction"] = defaultRouteActionProp
 	}
 
 	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/urlMaps")
@@ -2902,6 +2952,13 @@ func resourceComputeUrlMapRead(d *schema.ResourceData, meta interface{}) error {
 		return handleNotFoundError(err, d, fmt.Sprintf("ComputeUrlMap %q", d.Id()))
 	}
 
+	// Explicitly set virtual fields to default values if unset
+	if _, ok := d.GetOkExists("validate_on_plan"); !ok {
+		if err := d.Set("validate_on_plan", false); err != nil {
+			return fmt.Errorf("Error setting validate_on_plan: %s", err)
+		}
+	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading UrlMap: %s", err)
 	}
@@ -3119,6 +3176,11 @@ func resourceComputeUrlMapImport(d *schema.ResourceData, meta interface{}) ([]*s
 	}
 	d.SetId(id)
 
+	// Explicitly set virtual fields to default values on import
+	if err := d.Set("validate_on_plan", false); err != nil {
+		return nil, fmt.Errorf("Error setting validate_on_plan: %s", err)
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -38,6 +39,36 @@ func TestAccComputeUrlMap_update_path_matcher(t *testing.T) {
 	})
 }
 
+func TestAccComputeUrlMap_validateOnPlan(t *testing.T) {
+	t.Parallel()
+
+	bsName := fmt.Sprintf("urlmap-test-%s", randString(t, 10))
+	bs2Name := fmt.Sprintf("urlmap-test-%s", randString(t, 10))
+	hcName := fmt.Sprintf("urlmap-test-%s", randString(t, 10))
+	umName := fmt.Sprintf("urlmap-test-%s", randString(t, 10))
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeUrlMapDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeUrlMap_validateOnPlan(bsName, bs2Name, hcName, umName, "foobar"),
+			},
+			{
+				ResourceName:            "google_compute_url_map.foobar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate_on_plan"},
+			},
+			{
+				Config:      testAccComputeUrlMap_validateOnPlan(bsName, bs2Name, hcName, umName, "other"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("UrlMap validation failed"),
+			},
+		},
+	})
+}
+
 func TestAccComputeUrlMap_advanced(t *testing.T) {
 	t.Parallel()
 
@@ -361,6 +392,49 @@ func TestAccComputeUrlMap_defaultUrlRedirect(t *testing.T) {
 	})
 }
 
+func testAccComputeUrlMap_validateOnPlan(bsName, bs2Name, hcName, umName, testService string) string {
+	return fmt.Sprintf(`
+resource "google_compute_backend_service" "foobar" {
+  name          = "%s"
+  health_checks = [google_compute_http_health_check.zero.self_link]
+}
+
+resource "google_compute_backend_service" "other" {
+  name          = "%s"
+  health_checks = [google_compute_http_health_check.zero.self_link]
+}
+
+resource "google_compute_http_health_check" "zero" {
+  name               = "%s"
+  request_path       = "/"
+  check_interval_sec = 1
+  timeout_sec        = 1
+}
+
+resource "google_compute_url_map" "foobar" {
+  name             = "%s"
+  default_service  = google_compute_backend_service.foobar.self_link
+  validate_on_plan = true
+
+  host_rule {
+    hosts        = ["mysite.com"]
+    path_matcher = "boop"
+  }
+
+  path_matcher {
+    default_service = google_compute_backend_service.foobar.self_link
+    name            = "boop"
+  }
+
+  test {
+    host    = "mysite.com"
+    path    = "/"
+    service = google_compute_backend_service.%s.self_link
+  }
+}
+`, bsName, bs2Name, hcName, umName, testService)
+}
+
 func testAccComputeUrlMap_basic1(bsName, hcName, umName string) string {
 	return fmt.Sprintf(`
 resource "google_compute_backend_service" "foobar" {
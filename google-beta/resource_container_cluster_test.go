@@ -2714,6 +2714,188 @@ func TestAccContainerCluster_withDNSConfig(t *testing.T) {
 	})
 }
 
+func TestAccContainerCluster_withFleetConfig(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
+	projectID := getTestProjectFromEnv()
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withFleetConfig(clusterName, projectID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_container_cluster.with_fleet", "fleet.0.membership"),
+				),
+			},
+			{
+				ResourceName:      "google_container_cluster.with_fleet",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccContainerCluster_withoutFleetConfig(clusterName),
+				ExpectError: regexp.MustCompile("fleet.0.project can't be changed"),
+			},
+		},
+	})
+}
+
+func TestAccContainerCluster_withFleetConfigDefaultProject(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withFleetConfigDefaultProject(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_container_cluster.with_fleet", "fleet.0.project"),
+				),
+			},
+			{
+				// The project read back from the API doesn't show a diff
+				Config:   testAccContainerCluster_withFleetConfigDefaultProject(clusterName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "google_container_cluster.with_fleet",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccContainerCluster_withGatewayApiConfig(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withGatewayApiConfig(clusterName, "CHANNEL_STANDARD"),
+			},
+			{
+				ResourceName:      "google_container_cluster.primary",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContainerCluster_withGatewayApiConfig(clusterName, "CHANNEL_DISABLED"),
+			},
+			{
+				ResourceName:      "google_container_cluster.primary",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContainerCluster_withGatewayApiConfig(clusterName, "CHANNEL_STANDARD"),
+			},
+			{
+				// Removing the block keeps the channel of the cluster
+				Config:   testAccContainerCluster_withoutGatewayApiConfig(clusterName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccContainerCluster_withSecurityPostureConfig(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withSecurityPostureConfig(clusterName, "BASIC", "VULNERABILITY_BASIC"),
+			},
+			{
+				ResourceName:      "google_container_cluster.with_security_posture_config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContainerCluster_withSecurityPostureConfig(clusterName, "DISABLED", "VULNERABILITY_DISABLED"),
+			},
+			{
+				ResourceName:      "google_container_cluster.with_security_posture_config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccContainerCluster_withCostManagementConfig(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withCostManagementConfig(clusterName, true),
+			},
+			{
+				ResourceName:      "google_container_cluster.primary",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContainerCluster_withCostManagementConfig(clusterName, false),
+			},
+			{
+				ResourceName:      "google_container_cluster.primary",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccContainerCluster_withNodePoolAutoConfigNetworkTags(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withNodePoolAutoConfigNetworkTags(clusterName, `"foo"`),
+			},
+			{
+				ResourceName:      "google_container_cluster.with_autopilot",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContainerCluster_withNodePoolAutoConfigNetworkTags(clusterName, `"foo", "bar"`),
+			},
+			{
+				ResourceName:      "google_container_cluster.with_autopilot",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccContainerCluster_withTPUConfig(t *testing.T) {
 	t.Parallel()
 
@@ -5436,6 +5618,116 @@ resource "google_container_cluster" "with_dns_config" {
 `, clusterName, clusterDns, clusterDnsDomain, clusterDnsScope)
 }
 
+func testAccContainerCluster_withFleetConfig(clusterName, projectID string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_fleet" {
+  name               = "%s"
+  location           = "us-central1-a"
+  initial_node_count = 1
+  fleet {
+    project = "%s"
+  }
+}
+`, clusterName, projectID)
+}
+
+func testAccContainerCluster_withoutFleetConfig(clusterName string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_fleet" {
+  name               = "%s"
+  location           = "us-central1-a"
+  initial_node_count = 1
+}
+`, clusterName)
+}
+
+func testAccContainerCluster_withFleetConfigDefaultProject(clusterName string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_fleet" {
+  name               = "%s"
+  location           = "us-central1-a"
+  initial_node_count = 1
+  fleet {}
+}
+`, clusterName)
+}
+
+func testAccContainerCluster_withoutGatewayApiConfig(clusterName string) string {
+	return fmt.Sprintf(`
+data "google_container_engine_versions" "uscentral1a" {
+  location = "us-central1-a"
+}
+
+resource "google_container_cluster" "primary" {
+  name               = "%s"
+  location           = "us-central1-a"
+  initial_node_count = 1
+  min_master_version = data.google_container_engine_versions.uscentral1a.latest_master_version
+}
+`, clusterName)
+}
+
+func testAccContainerCluster_withGatewayApiConfig(clusterName, channel string) string {
+	return fmt.Sprintf(`
+data "google_container_engine_versions" "uscentral1a" {
+  location = "us-central1-a"
+}
+
+resource "google_container_cluster" "primary" {
+  name               = "%s"
+  location           = "us-central1-a"
+  initial_node_count = 1
+  min_master_version = data.google_container_engine_versions.uscentral1a.latest_master_version
+  gateway_api_config {
+    channel = "%s"
+  }
+}
+`, clusterName, channel)
+}
+
+func testAccContainerCluster_withSecurityPostureConfig(clusterName, mode, vulnerabilityMode string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_security_posture_config" {
+  name               = "%s"
+  location           = "us-central1-a"
+  initial_node_count = 1
+  security_posture_config {
+    mode               = "%s"
+    vulnerability_mode = "%s"
+  }
+}
+`, clusterName, mode, vulnerabilityMode)
+}
+
+func testAccContainerCluster_withCostManagementConfig(clusterName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "primary" {
+  name               = "%s"
+  location           = "us-central1-a"
+  initial_node_count = 1
+  cost_management_config {
+    enabled = %v
+  }
+}
+`, clusterName, enabled)
+}
+
+func testAccContainerCluster_withNodePoolAutoConfigNetworkTags(clusterName, tags string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_autopilot" {
+  name             = "%s"
+  location         = "us-central1"
+  enable_autopilot = true
+  ip_allocation_policy {}
+  node_pool_auto_config {
+    network_tags {
+      tags = [%s]
+    }
+  }
+}
+`, clusterName, tags)
+}
+
 func testAccContainerCluster_withIdentityServiceConfigEnabled(name string) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "primary" {
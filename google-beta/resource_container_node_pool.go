@@ -1,6 +1,7 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -39,6 +40,7 @@ func resourceContainerNodePool() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			resourceNodeConfigEmptyGuestAccelerator,
+			resourceNodePoolBlueGreenRolloutCustomizeDiff,
 		),
 
 		UseJSONNumber: true,
@@ -142,17 +144,119 @@ var schemaNodePool = map[string]*schema.Schema{
 			Schema: map[string]*schema.Schema{
 				"max_surge": {
 					Type:         schema.TypeInt,
-					Required:     true,
+					Optional:     true,
+					Computed:     true,
 					ValidateFunc: validation.IntAtLeast(0),
 					Description:  `The number of additional nodes that can be added to the node pool during an upgrade. Increasing max_surge raises the number of nodes that can be upgraded simultaneously. Can be set to 0 or greater.`,
 				},
 
 				"max_unavailable": {
 					Type:         schema.TypeInt,
-					Required:     true,
+					Optional:     true,
+					Computed:     true,
 					ValidateFunc: validation.IntAtLeast(0),
 					Description:  `The number of nodes that can be simultaneously unavailable during an upgrade. Increasing max_unavailable raises the number of nodes that can be upgraded in parallel. Can be set to 0 or greater.`,
 				},
+
+				"strategy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "SURGE",
+					ValidateFunc: validation.StringInSlice([]string{"SURGE", "BLUE_GREEN"}, false),
+					Description:  `Update strategy for the given nodepool.`,
+				},
+
+				"blue_green_settings": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Computed:    true,
+					MaxItems:    1,
+					Description: `Settings for BlueGreen node pool upgrade.`,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"standard_rollout_policy": {
+								Type:        schema.TypeList,
+								Required:    true,
+								MaxItems:    1,
+								Description: `Standard rollout policy is the default policy for blue-green.`,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"batch_percentage": {
+											Type:         schema.TypeFloat,
+											Optional:     true,
+											ValidateFunc: validation.FloatBetween(0.0, 1.0),
+											Description:  `Percentage of the blue pool nodes to drain in a batch. Exactly one of batch_percentage or batch_node_count must be specified.`,
+										},
+										"batch_node_count": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+											Description:  `Number of blue nodes to drain in a batch. Exactly one of batch_percentage or batch_node_count must be specified.`,
+										},
+										"batch_soak_duration": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Computed:    true,
+											Description: `Soak time after each batch gets drained. A duration in seconds with up to nine fractional digits, ending with 's'. Example: "3.5s".`,
+										},
+									},
+								},
+							},
+							"node_pool_soak_duration": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Computed:    true,
+								Description: `Time needed after draining entire blue pool. After this period, blue pool will be cleaned up. A duration in seconds with up to nine fractional digits, ending with 's'. Example: "3.5s".`,
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+
+	"update_info": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: `Information about the most recent upgrade of the node pool, populated while a blue-green upgrade is in progress.`,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"blue_green_info": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: `Information of a blue-green upgrade.`,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"phase": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: `Current blue-green upgrade phase.`,
+							},
+							"blue_instance_group_urls": {
+								Type:        schema.TypeList,
+								Computed:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: `The resource URLs of the managed instance groups associated with blue pool.`,
+							},
+							"green_instance_group_urls": {
+								Type:        schema.TypeList,
+								Computed:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: `The resource URLs of the managed instance groups associated with green pool.`,
+							},
+							"blue_pool_deletion_start_time": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: `Time to start deleting blue pool to complete blue-green upgrade, in RFC3339 text format.`,
+							},
+							"green_pool_version": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: `Version of green pool.`,
+							},
+						},
+					},
+				},
 			},
 		},
 	},
@@ -780,16 +884,7 @@ func expandNodePool(d *schema.ResourceData, prefix string) (*container.NodePool,
 	}
 
 	if v, ok := d.GetOk(prefix + "upgrade_settings"); ok {
-		upgradeSettingsConfig := v.([]interface{})[0].(map[string]interface{})
-		np.UpgradeSettings = &container.UpgradeSettings{}
-
-		if v, ok := upgradeSettingsConfig["max_surge"]; ok {
-			np.UpgradeSettings.MaxSurge = int64(v.(int))
-		}
-
-		if v, ok := upgradeSettingsConfig["max_unavailable"]; ok {
-			np.UpgradeSettings.MaxUnavailable = int64(v.(int))
-		}
+		np.UpgradeSettings = expandUpgradeSettings(v)
 	}
 
 	return np, nil
@@ -875,19 +970,158 @@ func flattenNodePool(d *schema.ResourceData, config *Config, np *container.NodeP
 	}
 
 	if np.UpgradeSettings != nil {
-		nodePool["upgrade_settings"] = []map[string]interface{}{
-			{
-				"max_surge":       np.UpgradeSettings.MaxSurge,
-				"max_unavailable": np.UpgradeSettings.MaxUnavailable,
-			},
-		}
+		nodePool["upgrade_settings"] = flattenUpgradeSettings(np.UpgradeSettings)
 	} else {
 		delete(nodePool, "upgrade_settings")
 	}
 
+	nodePool["update_info"] = flattenUpdateInfo(np.UpdateInfo)
+
 	return nodePool, nil
 }
 
+func expandUpgradeSettings(v interface{}) *container.UpgradeSettings {
+	upgradeSettings := &container.UpgradeSettings{}
+	if v == nil {
+		return upgradeSettings
+	}
+	ls := v.([]interface{})
+	if len(ls) == 0 || ls[0] == nil {
+		return upgradeSettings
+	}
+	upgradeSettingsConfig := ls[0].(map[string]interface{})
+
+	if v, ok := upgradeSettingsConfig["max_surge"]; ok {
+		upgradeSettings.MaxSurge = int64(v.(int))
+	}
+
+	if v, ok := upgradeSettingsConfig["max_unavailable"]; ok {
+		upgradeSettings.MaxUnavailable = int64(v.(int))
+	}
+
+	if v, ok := upgradeSettingsConfig["strategy"]; ok {
+		upgradeSettings.Strategy = v.(string)
+	}
+
+	if v, ok := upgradeSettingsConfig["blue_green_settings"]; ok {
+		upgradeSettings.BlueGreenSettings = expandBlueGreenSettings(v)
+	}
+
+	return upgradeSettings
+}
+
+// resourceNodePoolBlueGreenRolloutCustomizeDiff requires exactly one of the
+// batch sizes of a blue-green standard rollout policy. ExactlyOneOf can't be
+// used in schemaNodePool, as it's also nested in the node_pool list of
+// google_container_cluster, where the keys have no fixed path.
+func resourceNodePoolBlueGreenRolloutCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return validateNodePoolBlueGreenRollout(diff, "")
+}
+
+func validateNodePoolBlueGreenRollout(diff *schema.ResourceDiff, prefix string) error {
+	policy := prefix + "upgrade_settings.0.blue_green_settings.0.standard_rollout_policy"
+	if len(diff.Get(policy).([]interface{})) == 0 {
+		return nil
+	}
+	if !diff.NewValueKnown(policy+".0.batch_percentage") || !diff.NewValueKnown(policy+".0.batch_node_count") {
+		return nil
+	}
+	percentage := diff.Get(policy + ".0.batch_percentage").(float64)
+	count := diff.Get(policy + ".0.batch_node_count").(int)
+	if (percentage > 0) == (count > 0) {
+		return fmt.Errorf("exactly one of batch_percentage or batch_node_count must be set in %s", policy)
+	}
+	return nil
+}
+
+func expandBlueGreenSettings(v interface{}) *container.BlueGreenSettings {
+	ls := v.([]interface{})
+	if len(ls) == 0 || ls[0] == nil {
+		return nil
+	}
+	blueGreenSettingsConfig := ls[0].(map[string]interface{})
+
+	blueGreenSettings := &container.BlueGreenSettings{
+		NodePoolSoakDuration: blueGreenSettingsConfig["node_pool_soak_duration"].(string),
+	}
+
+	if v, ok := blueGreenSettingsConfig["standard_rollout_policy"]; ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		standardRolloutPolicyConfig := v.([]interface{})[0].(map[string]interface{})
+		blueGreenSettings.StandardRolloutPolicy = &container.StandardRolloutPolicy{
+			BatchSoakDuration: standardRolloutPolicyConfig["batch_soak_duration"].(string),
+		}
+		// The API accepts only one of the batch sizes
+		if v := standardRolloutPolicyConfig["batch_percentage"].(float64); v > 0 {
+			blueGreenSettings.StandardRolloutPolicy.BatchPercentage = v
+		} else {
+			blueGreenSettings.StandardRolloutPolicy.BatchNodeCount = int64(standardRolloutPolicyConfig["batch_node_count"].(int))
+		}
+	}
+
+	return blueGreenSettings
+}
+
+func flattenUpgradeSettings(us *container.UpgradeSettings) []map[string]interface{} {
+	if us == nil {
+		return nil
+	}
+
+	upgradeSettings := map[string]interface{}{
+		"max_surge":           us.MaxSurge,
+		"max_unavailable":     us.MaxUnavailable,
+		"strategy":            us.Strategy,
+		"blue_green_settings": flattenBlueGreenSettings(us.BlueGreenSettings),
+	}
+	// The API omits the strategy for node pools that have never had it set,
+	// which is equivalent to a surge upgrade.
+	if us.Strategy == "" {
+		upgradeSettings["strategy"] = "SURGE"
+	}
+
+	return []map[string]interface{}{upgradeSettings}
+}
+
+func flattenBlueGreenSettings(bgs *container.BlueGreenSettings) []map[string]interface{} {
+	if bgs == nil {
+		return nil
+	}
+
+	blueGreenSettings := map[string]interface{}{
+		"node_pool_soak_duration": bgs.NodePoolSoakDuration,
+	}
+	if bgs.StandardRolloutPolicy != nil {
+		blueGreenSettings["standard_rollout_policy"] = []map[string]interface{}{
+			{
+				"batch_percentage":    bgs.StandardRolloutPolicy.BatchPercentage,
+				"batch_node_count":    bgs.StandardRolloutPolicy.BatchNodeCount,
+				"batch_soak_duration": bgs.StandardRolloutPolicy.BatchSoakDuration,
+			},
+		}
+	}
+
+	return []map[string]interface{}{blueGreenSettings}
+}
+
+func flattenUpdateInfo(ui *container.UpdateInfo) []map[string]interface{} {
+	if ui == nil || ui.BlueGreenInfo == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"blue_green_info": []map[string]interface{}{
+				{
+					"phase":                         ui.BlueGreenInfo.Phase,
+					"blue_instance_group_urls":      ui.BlueGreenInfo.BlueInstanceGroupUrls,
+					"green_instance_group_urls":     ui.BlueGreenInfo.GreenInstanceGroupUrls,
+					"blue_pool_deletion_start_time": ui.BlueGreenInfo.BluePoolDeletionStartTime,
+					"green_pool_version":            ui.BlueGreenInfo.GreenPoolVersion,
+				},
+			},
+		},
+	}
+}
+
 func flattenNodeNetworkConfig(c *container.NodeNetworkConfig, d *schema.ResourceData, prefix string) []map[string]interface{} {
 	result := []map[string]interface{}{}
 	if c != nil {
@@ -1198,6 +1432,12 @@ func nodePoolUpdate(d *schema.ResourceData, meta interface{}, nodePoolInfo *Node
 			NodePoolId:  name,
 			NodeVersion: d.Get(prefix + "version").(string),
 		}
+		activity := "updating GKE node pool version"
+		if d.Get(prefix+"upgrade_settings.0.strategy").(string) == "BLUE_GREEN" {
+			// A blue-green upgrade's operation stays running through the batch and
+			// node pool soak phases, so the wait below covers the whole rollout.
+			activity = "upgrading GKE node pool version using blue-green strategy"
+		}
 		updateF := func() error {
 			clusterNodePoolsUpdateCall := config.NewContainerClient(userAgent).Projects.Locations.Clusters.NodePools.Update(nodePoolInfo.fullyQualifiedName(name), req)
 			if config.UserProjectOverride {
@@ -1212,7 +1452,7 @@ func nodePoolUpdate(d *schema.ResourceData, meta interface{}, nodePoolInfo *Node
 			// Wait until it's updated
 			return containerOperationWait(config, op,
 				nodePoolInfo.project,
-				nodePoolInfo.location, "updating GKE node pool version", userAgent, timeout)
+				nodePoolInfo.location, activity, userAgent, timeout)
 		}
 
 		// Call update serially.
@@ -1254,8 +1494,18 @@ func nodePoolUpdate(d *schema.ResourceData, meta interface{}, nodePoolInfo *Node
 		upgradeSettings := &container.UpgradeSettings{}
 		if v, ok := d.GetOk(prefix + "upgrade_settings"); ok {
 			upgradeSettingsConfig := v.([]interface{})[0].(map[string]interface{})
-			upgradeSettings.MaxSurge = int64(upgradeSettingsConfig["max_surge"].(int))
-			upgradeSettings.MaxUnavailable = int64(upgradeSettingsConfig["max_unavailable"].(int))
+			upgradeSettings.Strategy = upgradeSettingsConfig["strategy"].(string)
+
+			// Only the settings belonging to the selected strategy are sent, the
+			// others are retained by the API but ignored during upgrades.
+			switch upgradeSettings.Strategy {
+			case "SURGE":
+				upgradeSettings.MaxSurge = int64(upgradeSettingsConfig["max_surge"].(int))
+				upgradeSettings.MaxUnavailable = int64(upgradeSettingsConfig["max_unavailable"].(int))
+				upgradeSettings.ForceSendFields = []string{"MaxSurge", "MaxUnavailable"}
+			case "BLUE_GREEN":
+				upgradeSettings.BlueGreenSettings = expandBlueGreenSettings(upgradeSettingsConfig["blue_green_settings"])
+			}
 		}
 		req := &container.UpdateNodePoolRequest{
 			UpgradeSettings: upgradeSettings,
@@ -1320,6 +1570,9 @@ func containerNodePoolAwaitRestingState(config *Config, name, project, userAgent
 			log.Printf("[DEBUG] NodePool %q has error state %q with message %q.", name, state, nodePool.StatusMessage)
 			return nil
 		default:
+			if nodePool.UpdateInfo != nil && nodePool.UpdateInfo.BlueGreenInfo != nil {
+				return resource.RetryableError(fmt.Errorf("NodePool %q has state %q in blue-green upgrade phase %q with message %q", name, state, nodePool.UpdateInfo.BlueGreenInfo.Phase, nodePool.StatusMessage))
+			}
 			return resource.RetryableError(fmt.Errorf("NodePool %q has state %q with message %q", name, state, nodePool.StatusMessage))
 		}
 	})
@@ -462,6 +462,49 @@ func TestAccContainerNodePool_withUpgradeSettings(t *testing.T) {
 	})
 }
 
+func TestAccContainerNodePool_withBlueGreenUpgradeSettings(t *testing.T) {
+	t.Parallel()
+
+	cluster := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
+	np := fmt.Sprintf("tf-test-np-%s", randString(t, 10))
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerNodePool_withBlueGreenUpgradeSettings(cluster, np, "batch_percentage = 0.5", "10s"),
+			},
+			{
+				ResourceName:      "google_container_node_pool.with_upgrade_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContainerNodePool_withBlueGreenUpgradeSettings(cluster, np, "batch_node_count = 2", "20s"),
+			},
+			{
+				ResourceName:      "google_container_node_pool.with_upgrade_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccContainerNodePool_withBlueGreenUpgradeSettings(cluster, np, "batch_percentage = 0.5\n        batch_node_count = 2", "20s"),
+				ExpectError: regexp.MustCompile("exactly one of batch_percentage or batch_node_count must be set"),
+			},
+			{
+				Config: testAccContainerNodePool_withUpgradeSettings(cluster, np, 2, 3),
+			},
+			{
+				ResourceName:      "google_container_node_pool.with_upgrade_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccContainerNodePool_withGPU(t *testing.T) {
 	t.Parallel()
 
@@ -1934,6 +1977,38 @@ resource "google_container_node_pool" "with_upgrade_settings" {
 `, clusterName, nodePoolName, maxSurge, maxUnavailable)
 }
 
+func testAccContainerNodePool_withBlueGreenUpgradeSettings(clusterName, nodePoolName, batchSize, soakDuration string) string {
+	return fmt.Sprintf(`
+data "google_container_engine_versions" "central1" {
+  location = "us-central1"
+}
+
+resource "google_container_cluster" "cluster" {
+  name               = "%s"
+  location           = "us-central1"
+  initial_node_count = 1
+  min_master_version = "${data.google_container_engine_versions.central1.latest_master_version}"
+}
+
+resource "google_container_node_pool" "with_upgrade_settings" {
+  name = "%s"
+  location = "us-central1"
+  cluster = "${google_container_cluster.cluster.name}"
+  initial_node_count = 1
+  upgrade_settings {
+    strategy = "BLUE_GREEN"
+    blue_green_settings {
+      standard_rollout_policy {
+        %s
+        batch_soak_duration = "%s"
+      }
+      node_pool_soak_duration = "%s"
+    }
+  }
+}
+`, clusterName, nodePoolName, batchSize, soakDuration, soakDuration)
+}
+
 func testAccContainerNodePool_withGPU(cluster, np string) string {
 	return fmt.Sprintf(`
 data "google_container_engine_versions" "central1c" {
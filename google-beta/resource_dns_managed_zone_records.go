@@ -0,0 +1,482 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/dns/v1"
+)
+
+func resourceDnsManagedZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsManagedZoneRecordsCreate,
+		Read:   resourceDnsManagedZoneRecordsRead,
+		Update: resourceDnsManagedZoneRecordsUpdate,
+		Delete: resourceDnsManagedZoneRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsManagedZoneRecordsImportState,
+		},
+
+		CustomizeDiff: resourceDnsManagedZoneRecordsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"managed_zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+				Description:      `The name of the zone whose records are managed.`,
+			},
+
+			"zone_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"zone_file", "record"},
+				Description:  `The contents of a zone file in BIND format. Relative names are relative to the DNS name of the zone, unless an $ORIGIN directive is used.`,
+			},
+
+			"record": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"zone_file", "record"},
+				Description:  `A record set of the zone, in addition to the ones in zone_file.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The DNS name of the record set. Names not ending with a "." are relative to the DNS name of the zone, and "@" is the zone's apex.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The DNS record set type.`,
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     300,
+							Description: `The time-to-live of this record set (seconds).`,
+						},
+						"rrdatas": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: `The string data for the records in this record set, in the format of a zone file.`,
+						},
+					},
+				},
+			},
+
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The ID of the project in which the resource belongs. If it is not provided, the provider project is used.`,
+			},
+
+			"rrsets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The record sets of the zone managed by this resource.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rrdatas": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func dnsRecordSetKey(rrset *dns.ResourceRecordSet) string {
+	return strings.ToLower(rrset.Name) + "/" + strings.ToUpper(rrset.Type)
+}
+
+// isDnsManagedZoneRecordsManaged reports whether a record set of the zone is
+// managed. The SOA and NS record sets of the apex are left alone, unless they
+// are part of managedKeys.
+func isDnsManagedZoneRecordsManaged(rrset *dns.ResourceRecordSet, zoneDnsName string, managedKeys map[string]bool) bool {
+	rType := strings.ToUpper(rrset.Type)
+	if strings.EqualFold(rrset.Name, zoneDnsName) && (rType == "SOA" || rType == "NS") {
+		return managedKeys[dnsRecordSetKey(rrset)]
+	}
+	return true
+}
+
+// expandDnsManagedZoneRecords returns the normalized record sets configured
+// through zone_file and record.
+func expandDnsManagedZoneRecords(d interface{ Get(string) interface{} }, zoneDnsName string) ([]*dns.ResourceRecordSet, error) {
+	var rrsets []*dns.ResourceRecordSet
+	if zoneFile := d.Get("zone_file").(string); zoneFile != "" {
+		parsed, err := parseDnsZoneFile(zoneFile, zoneDnsName)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing zone_file: %s", err)
+		}
+		rrsets = append(rrsets, parsed...)
+	}
+
+	for _, raw := range d.Get("record").([]interface{}) {
+		if raw == nil {
+			continue
+		}
+		r := raw.(map[string]interface{})
+		rType := strings.ToUpper(r["type"].(string))
+		rrset := &dns.ResourceRecordSet{
+			Name: strings.ToLower(qualifyDnsName(r["name"].(string), zoneDnsName)),
+			Type: rType,
+			Ttl:  int64(r["ttl"].(int)),
+		}
+		for _, rrdata := range convertStringArr(r["rrdatas"].([]interface{})) {
+			entries, err := lexDnsZoneFile(rrdata)
+			if err != nil || len(entries) != 1 {
+				return nil, fmt.Errorf("invalid rrdatas %q of record %s %s", rrdata, rrset.Name, rType)
+			}
+			rrset.Rrdatas = append(rrset.Rrdatas, formatDnsZoneFileRdata(rType, entries[0].tokens, zoneDnsName))
+		}
+		rrsets = append(rrsets, rrset)
+	}
+
+	seen := make(map[string]bool)
+	for _, rrset := range rrsets {
+		if !isDnsNameInZone(rrset.Name, zoneDnsName) {
+			return nil, fmt.Errorf("record %s %s is not part of the zone %s", rrset.Name, rrset.Type, zoneDnsName)
+		}
+		key := dnsRecordSetKey(rrset)
+		if seen[key] {
+			return nil, fmt.Errorf("record %s %s is set more than once in zone_file and record", rrset.Name, rrset.Type)
+		}
+		seen[key] = true
+	}
+
+	return normalizeDnsRecordSets(rrsets), nil
+}
+
+func flattenDnsManagedZoneRecords(rrsets []*dns.ResourceRecordSet) []interface{} {
+	transformed := make([]interface{}, 0, len(rrsets))
+	for _, rrset := range rrsets {
+		transformed = append(transformed, map[string]interface{}{
+			"name":    rrset.Name,
+			"type":    rrset.Type,
+			"ttl":     int(rrset.Ttl),
+			"rrdatas": rrset.Rrdatas,
+		})
+	}
+	return transformed
+}
+
+// dnsManagedZoneRecordsStateKeys returns the keys of the record sets in state.
+func dnsManagedZoneRecordsStateKeys(d *schema.ResourceData) map[string]bool {
+	keys := make(map[string]bool)
+	for _, raw := range d.Get("rrsets").([]interface{}) {
+		r := raw.(map[string]interface{})
+		keys[dnsRecordSetKey(&dns.ResourceRecordSet{Name: r["name"].(string), Type: r["type"].(string)})] = true
+	}
+	return keys
+}
+
+func getDnsManagedZoneDnsName(config *Config, userAgent, project, zone string) (string, error) {
+	mz, err := config.NewDnsClient(userAgent).ManagedZones.Get(project, zone).Do()
+	if err != nil {
+		return "", err
+	}
+	return strings.ToLower(mz.DnsName), nil
+}
+
+func listDnsRecordSets(config *Config, userAgent, project, zone string) ([]*dns.ResourceRecordSet, error) {
+	var rrsets []*dns.ResourceRecordSet
+	err := config.NewDnsClient(userAgent).ResourceRecordSets.List(project, zone).Pages(context.Background(), func(res *dns.ResourceRecordSetsListResponse) error {
+		rrsets = append(rrsets, res.Rrsets...)
+		return nil
+	})
+	return rrsets, err
+}
+
+func resourceDnsManagedZoneRecordsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("managed_zone") || !d.NewValueKnown("zone_file") || !d.NewValueKnown("record") {
+		return d.SetNewComputed("rrsets")
+	}
+
+	config := meta.(*Config)
+	project, err := getProjectFromDiff(d, config)
+	if err != nil {
+		return err
+	}
+	zone := GetResourceNameFromSelfLink(d.Get("managed_zone").(string))
+
+	dnsName, err := getDnsManagedZoneDnsName(config, config.userAgent, project, zone)
+	if err != nil {
+		if isGoogleApiErrorWithCode(err, 404) {
+			// The zone is created in the same apply
+			return d.SetNewComputed("rrsets")
+		}
+		return fmt.Errorf("Error retrieving managed zone %q: %s", zone, err)
+	}
+
+	desired, err := expandDnsManagedZoneRecords(d, dnsName)
+	if err != nil {
+		return err
+	}
+
+	flattened := flattenDnsManagedZoneRecords(desired)
+	old := d.Get("rrsets").([]interface{})
+	if len(old) == len(flattened) && reflect.DeepEqual(flattenDnsManagedZoneRecords(expandDnsManagedZoneRecordsState(old)), flattened) {
+		return nil
+	}
+	return d.SetNew("rrsets", flattened)
+}
+
+func expandDnsManagedZoneRecordsState(v []interface{}) []*dns.ResourceRecordSet {
+	rrsets := make([]*dns.ResourceRecordSet, 0, len(v))
+	for _, raw := range v {
+		r := raw.(map[string]interface{})
+		rrsets = append(rrsets, &dns.ResourceRecordSet{
+			Name:    r["name"].(string),
+			Type:    r["type"].(string),
+			Ttl:     int64(r["ttl"].(int)),
+			Rrdatas: convertStringArr(r["rrdatas"].([]interface{})),
+		})
+	}
+	return rrsets
+}
+
+func resourceDnsManagedZoneRecordsCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	zone := GetResourceNameFromSelfLink(d.Get("managed_zone").(string))
+
+	if err := applyDnsManagedZoneRecords(d, config, userAgent, project, zone); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/managedZones/%s/records", project, zone))
+
+	return resourceDnsManagedZoneRecordsRead(d, meta)
+}
+
+func resourceDnsManagedZoneRecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	zone := GetResourceNameFromSelfLink(d.Get("managed_zone").(string))
+
+	if err := applyDnsManagedZoneRecords(d, config, userAgent, project, zone); err != nil {
+		return err
+	}
+
+	return resourceDnsManagedZoneRecordsRead(d, meta)
+}
+
+// applyDnsManagedZoneRecords replaces the record sets of the zone that don't
+// match the configured ones, and deletes the extra ones, in a single change.
+func applyDnsManagedZoneRecords(d *schema.ResourceData, config *Config, userAgent, project, zone string) error {
+	dnsName, err := getDnsManagedZoneDnsName(config, userAgent, project, zone)
+	if err != nil {
+		return fmt.Errorf("Error retrieving managed zone %q: %s", zone, err)
+	}
+
+	desired, err := expandDnsManagedZoneRecords(d, dnsName)
+	if err != nil {
+		return err
+	}
+	desiredByKey := make(map[string]*dns.ResourceRecordSet)
+	for _, rrset := range desired {
+		desiredByKey[dnsRecordSetKey(rrset)] = rrset
+	}
+
+	current, err := listDnsRecordSets(config, userAgent, project, zone)
+	if err != nil {
+		return fmt.Errorf("Error retrieving record sets for %q: %s", zone, err)
+	}
+
+	chg := &dns.Change{}
+	unchanged := make(map[string]bool)
+	for _, rrset := range current {
+		key := dnsRecordSetKey(rrset)
+		want, ok := desiredByKey[key]
+		if !isDnsManagedZoneRecordsManaged(rrset, dnsName, map[string]bool{key: ok}) {
+			continue
+		}
+		if ok && rrset.RoutingPolicy == nil && reflect.DeepEqual(normalizeDnsRecordSets([]*dns.ResourceRecordSet{rrset})[0], want) {
+			unchanged[key] = true
+			continue
+		}
+		chg.Deletions = append(chg.Deletions, rrset)
+	}
+	for _, rrset := range desired {
+		if !unchanged[dnsRecordSetKey(rrset)] {
+			chg.Additions = append(chg.Additions, rrset)
+		}
+	}
+
+	if len(chg.Additions) == 0 && len(chg.Deletions) == 0 {
+		log.Printf("[DEBUG] Records of managed zone %q are up to date", zone)
+		return nil
+	}
+
+	log.Printf("[DEBUG] DNS records change request for %q: %d addition(s), %d deletion(s)", zone, len(chg.Additions), len(chg.Deletions))
+	return createDnsChange(config, userAgent, project, zone, chg)
+}
+
+func createDnsChange(config *Config, userAgent, project, zone string, chg *dns.Change) error {
+	chg, err := config.NewDnsClient(userAgent).Changes.Create(project, zone, chg).Do()
+	if err != nil {
+		return fmt.Errorf("Error changing DNS records of %q: %s", zone, err)
+	}
+
+	w := &DnsChangeWaiter{
+		Service:     config.NewDnsClient(userAgent),
+		Change:      chg,
+		Project:     project,
+		ManagedZone: zone,
+	}
+	if _, err = w.Conf().WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Google DNS change: %s", err)
+	}
+	return nil
+}
+
+func resourceDnsManagedZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	zone := GetResourceNameFromSelfLink(d.Get("managed_zone").(string))
+
+	dnsName, err := getDnsManagedZoneDnsName(config, userAgent, project, zone)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DNS Managed Zone Records %q", zone))
+	}
+
+	current, err := listDnsRecordSets(config, userAgent, project, zone)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DNS Managed Zone Records %q", zone))
+	}
+
+	managedKeys := dnsManagedZoneRecordsStateKeys(d)
+	var managed []*dns.ResourceRecordSet
+	for _, rrset := range current {
+		if isDnsManagedZoneRecordsManaged(rrset, dnsName, managedKeys) {
+			managed = append(managed, rrset)
+		}
+	}
+
+	if err := d.Set("rrsets", flattenDnsManagedZoneRecords(normalizeDnsRecordSets(managed))); err != nil {
+		return fmt.Errorf("Error setting rrsets: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDnsManagedZoneRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	zone := GetResourceNameFromSelfLink(d.Get("managed_zone").(string))
+
+	dnsName, err := getDnsManagedZoneDnsName(config, userAgent, project, zone)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DNS Managed Zone Records %q", zone))
+	}
+
+	current, err := listDnsRecordSets(config, userAgent, project, zone)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DNS Managed Zone Records %q", zone))
+	}
+
+	// The SOA and NS record sets of the apex can't be deleted
+	managedKeys := dnsManagedZoneRecordsStateKeys(d)
+	chg := &dns.Change{}
+	for _, rrset := range current {
+		if managedKeys[dnsRecordSetKey(rrset)] && isDnsManagedZoneRecordsManaged(rrset, dnsName, nil) {
+			chg.Deletions = append(chg.Deletions, rrset)
+		}
+	}
+
+	if len(chg.Deletions) > 0 {
+		log.Printf("[DEBUG] Deleting %d DNS record set(s) of %q", len(chg.Deletions), zone)
+		if err := createDnsChange(config, userAgent, project, zone, chg); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDnsManagedZoneRecordsImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/managedZones/(?P<managed_zone>[^/]+)/records",
+		"(?P<project>[^/]+)/(?P<managed_zone>[^/]+)",
+		"(?P<managed_zone>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/managedZones/{{managed_zone}}/records")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,87 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"google.golang.org/api/dns/v1"
+)
+
+func TestAccDNSManagedZoneRecords_zoneFile(t *testing.T) {
+	t.Parallel()
+
+	zoneName := fmt.Sprintf("dnszone-test-%s", randString(t, 10))
+	dnsName := fmt.Sprintf("%s.hashicorptest.com.", zoneName)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsManagedZoneRecords_zoneFile(zoneName, "192.0.2.1", `extra   TXT "to be removed"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_dns_managed_zone_records.records", "rrsets.#", "6"),
+				),
+			},
+			{
+				ResourceName:            "google_dns_managed_zone_records.records",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zone_file", "record"},
+			},
+			{
+				PreConfig: func() {
+					// A record set created outside of Terraform is removed
+					config := googleProviderConfig(t)
+					chg := &dns.Change{
+						Additions: []*dns.ResourceRecordSet{
+							{Name: "stray." + dnsName, Type: "A", Ttl: 300, Rrdatas: []string{"192.0.2.100"}},
+						},
+					}
+					if err := createDnsChange(config, config.userAgent, getTestProjectFromEnv(), zoneName, chg); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDnsManagedZoneRecords_zoneFile(zoneName, "192.0.2.2", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_dns_managed_zone_records.records", "rrsets.#", "5"),
+					resource.TestCheckResourceAttr("google_dns_managed_zone_records.records", "rrsets.4.name", "www."+dnsName),
+					resource.TestCheckResourceAttr("google_dns_managed_zone_records.records", "rrsets.4.rrdatas.#", "2"),
+					resource.TestCheckResourceAttr("google_dns_managed_zone_records.records", "rrsets.4.rrdatas.1", "192.0.2.2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDnsManagedZoneRecords_zoneFile(zoneName, addr, extra string) string {
+	return fmt.Sprintf(`
+resource "google_dns_managed_zone" "zone" {
+  name        = "%s"
+  dns_name    = "%s.hashicorptest.com."
+  description = "Test Description"
+}
+
+resource "google_dns_managed_zone_records" "records" {
+  managed_zone = google_dns_managed_zone.zone.name
+
+  zone_file = <<-EOT
+    $TTL 300
+    @       IN  MX    10 mail
+    @       IN  TXT   "v=spf1 -all" "second string"
+    mail    IN  A     192.0.2.10
+    www     IN  A     192.0.2.0
+                A     %s
+    %s
+  EOT
+
+  record {
+    name    = "api"
+    type    = "CNAME"
+    ttl     = 60
+    rrdatas = ["www"]
+  }
+}
+`, zoneName, zoneName, addr, extra)
+}
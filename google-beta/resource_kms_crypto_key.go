@@ -88,6 +88,15 @@ If not specified at creation time, the default duration is 24 hours.`,
 				Description: `Labels with user-defined metadata to apply to this resource.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"primary_version": {
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				DiffSuppressFunc: kmsCryptoKeyVersionsEquivalent,
+				Description: `The ID of the CryptoKeyVersion to use as primary, e.g. '2', or its full resource name.
+Only supported for keys with the ENCRYPT_DECRYPT purpose. Setting it pins the primary version,
+so a version created by automatic rotation is reverted on the next apply.`,
+			},
 			"purpose": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -224,6 +233,16 @@ func resourceKMSCryptoKeyCreate(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Finished creating CryptoKey %q: %#v", d.Id(), res)
 
+	if v, ok := d.GetOk("primary_version"); ok {
+		cryptoKeyId, err := parseKmsCryptoKeyId(d.Id(), config)
+		if err != nil {
+			return err
+		}
+		if err := updateCryptoKeyPrimaryVersion(cryptoKeyId, v.(string), userAgent, config); err != nil {
+			return fmt.Errorf("Error updating primary version of CryptoKey %q: %s", d.Id(), err)
+		}
+	}
+
 	return resourceKMSCryptoKeyRead(d, meta)
 }
 
@@ -285,6 +304,9 @@ func resourceKMSCryptoKeyRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("import_only", flattenKMSCryptoKeyImportOnly(res["importOnly"], d, config)); err != nil {
 		return fmt.Errorf("Error reading CryptoKey: %s", err)
 	}
+	if err := d.Set("primary_version", flattenKMSCryptoKeyPrimaryVersion(res["primary"], d, config)); err != nil {
+		return fmt.Errorf("Error reading CryptoKey: %s", err)
+	}
 
 	return nil
 }
@@ -358,12 +380,25 @@ func resourceKMSCryptoKeyUpdate(d *schema.ResourceData, meta interface{}) error
 		billingProject = bp
 	}
 
-	res, err := sendRequestWithTimeout(config, "PATCH", billingProject, url, userAgent, obj, d.Timeout(schema.TimeoutUpdate))
+	// if updateMask is empty we are not updating anything so skip the post
+	if len(updateMask) > 0 {
+		res, err := sendRequestWithTimeout(config, "PATCH", billingProject, url, userAgent, obj, d.Timeout(schema.TimeoutUpdate))
 
-	if err != nil {
-		return fmt.Errorf("Error updating CryptoKey %q: %s", d.Id(), err)
-	} else {
-		log.Printf("[DEBUG] Finished updating CryptoKey %q: %#v", d.Id(), res)
+		if err != nil {
+			return fmt.Errorf("Error updating CryptoKey %q: %s", d.Id(), err)
+		} else {
+			log.Printf("[DEBUG] Finished updating CryptoKey %q: %#v", d.Id(), res)
+		}
+	}
+
+	if d.HasChange("primary_version") {
+		cryptoKeyId, err := parseKmsCryptoKeyId(d.Id(), config)
+		if err != nil {
+			return err
+		}
+		if err := updateCryptoKeyPrimaryVersion(cryptoKeyId, d.Get("primary_version").(string), userAgent, config); err != nil {
+			return fmt.Errorf("Error updating primary version of CryptoKey %q: %s", d.Id(), err)
+		}
 	}
 
 	return resourceKMSCryptoKeyRead(d, meta)
@@ -475,6 +510,21 @@ func flattenKMSCryptoKeyImportOnly(v interface{}, d *schema.ResourceData, config
 	return v
 }
 
+func flattenKMSCryptoKeyPrimaryVersion(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	if v == nil {
+		return nil
+	}
+	original, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	name, ok := original["name"].(string)
+	if !ok {
+		return nil
+	}
+	return GetResourceNameFromSelfLink(name)
+}
+
 func expandKMSCryptoKeyLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
 	if v == nil {
 		return map[string]string{}, nil
@@ -0,0 +1,325 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var kmsCryptoKeyVersionIdRegex = regexp.MustCompile("^(projects/" + ProjectRegex + "/locations/[a-z0-9-]+/keyRings/[a-zA-Z0-9_-]{1,63}/cryptoKeys/[a-zA-Z0-9_-]{1,63})/cryptoKeyVersions/([0-9]+)$")
+
+func resourceKMSCryptoKeyVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKMSCryptoKeyVersionCreate,
+		Read:   resourceKMSCryptoKeyVersionRead,
+		Update: resourceKMSCryptoKeyVersionUpdate,
+		Delete: resourceKMSCryptoKeyVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceKMSCryptoKeyVersionImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"crypto_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: `The name of the cryptoKey associated with the CryptoKeyVersions.
+Format: 'projects/{{project}}/locations/{{location}}/keyRings/{{keyring}}/cryptoKeys/{{cryptoKey}}'`,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ENABLED", "DISABLED", "DESTROY_SCHEDULED"}, false),
+				Description: `The current state of the CryptoKeyVersion. Setting it to DESTROY_SCHEDULED schedules the version
+for destruction, and setting it back to ENABLED or DISABLED restores it before it's destroyed.
+Possible values: ["ENABLED", "DISABLED", "DESTROY_SCHEDULED"]`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The resource name for this CryptoKeyVersion.`,
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of this CryptoKeyVersion within its CryptoKey.`,
+			},
+			"algorithm": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The CryptoKeyVersionAlgorithm that this CryptoKeyVersion supports.`,
+			},
+			"protection_level": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ProtectionLevel describing how crypto operations are performed with this CryptoKeyVersion.`,
+			},
+			"generate_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time this CryptoKeyVersion's key material was generated.`,
+			},
+			"destroy_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time this CryptoKeyVersion's key material is scheduled for destruction. Only present if state is DESTROY_SCHEDULED.`,
+			},
+			"import_job": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The name of the ImportJob used in the most recent import of this CryptoKeyVersion. Only present if the underlying key material was imported.`,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceKMSCryptoKeyVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	cryptoKeyId, err := parseKmsCryptoKeyId(d.Get("crypto_key").(string), config)
+	if err != nil {
+		return err
+	}
+	billingProject := cryptoKeyId.KeyRingId.Project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	state := d.Get("state").(string)
+	if state == "DESTROY_SCHEDULED" {
+		return fmt.Errorf("Error creating CryptoKeyVersion: a new version can't be created in the DESTROY_SCHEDULED state")
+	}
+
+	obj := make(map[string]interface{})
+	if state != "" {
+		obj["state"] = state
+	}
+
+	url, err := replaceVars(d, config, "{{KMSBasePath}}"+cryptoKeyId.cryptoKeyId()+"/cryptoKeyVersions")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new CryptoKeyVersion: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", billingProject, url, userAgent, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating CryptoKeyVersion: %s", err)
+	}
+
+	name, ok := res["name"].(string)
+	if !ok {
+		return fmt.Errorf("Error creating CryptoKeyVersion: the response has no name")
+	}
+	d.SetId(name)
+
+	log.Printf("[DEBUG] Finished creating CryptoKeyVersion %q: %#v", d.Id(), res)
+
+	// Key material of HSM and external versions is generated asynchronously
+	err = PollingWaitTime(resourceKMSCryptoKeyVersionPollRead(d, meta), pollCheckKMSCryptoKeyVersionGenerated, "Creating CryptoKeyVersion", d.Timeout(schema.TimeoutCreate), 1)
+	if err != nil {
+		return fmt.Errorf("Error waiting for CryptoKeyVersion key material to be generated: %s", err)
+	}
+
+	return resourceKMSCryptoKeyVersionRead(d, meta)
+}
+
+func resourceKMSCryptoKeyVersionPollRead(d *schema.ResourceData, meta interface{}) PollReadFunc {
+	return func() (map[string]interface{}, error) {
+		config := meta.(*Config)
+		userAgent, err := generateUserAgentString(d, config.userAgent)
+		if err != nil {
+			return nil, err
+		}
+
+		billingProject := ""
+		if parts := regexp.MustCompile(`projects\/([^\/]+)\/`).FindStringSubmatch(d.Id()); parts != nil {
+			billingProject = parts[1]
+		}
+
+		// err == nil indicates that the billing_project value was found
+		if bp, err := getBillingProject(d, config); err == nil {
+			billingProject = bp
+		}
+
+		return sendRequest(config, "GET", billingProject, config.KMSBasePath+d.Id(), userAgent, nil)
+	}
+}
+
+func pollCheckKMSCryptoKeyVersionGenerated(res map[string]interface{}, respErr error) PollResult {
+	if respErr != nil {
+		return ErrorPollResult(respErr)
+	}
+	if state, _ := res["state"].(string); state == "PENDING_GENERATION" || state == "PENDING_IMPORT" {
+		return PendingStatusPollResult(state)
+	}
+	return SuccessPollResult()
+}
+
+func resourceKMSCryptoKeyVersionRead(d *schema.ResourceData, meta interface{}) error {
+	res, err := resourceKMSCryptoKeyVersionPollRead(d, meta)()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("KMSCryptoKeyVersion %q", d.Id()))
+	}
+
+	// Destroyed versions can't be restored anymore, so they're gone for good
+	if res["state"] == "DESTROYED" {
+		log.Printf("[DEBUG] Removing KMSCryptoKeyVersion %q because it was destroyed.", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	parts := kmsCryptoKeyVersionIdRegex.FindStringSubmatch(d.Id())
+	if parts == nil {
+		return fmt.Errorf("Invalid CryptoKeyVersion id %q", d.Id())
+	}
+
+	if err := d.Set("crypto_key", parts[1]); err != nil {
+		return fmt.Errorf("Error reading CryptoKeyVersion: %s", err)
+	}
+	if err := d.Set("version", parts[2]); err != nil {
+		return fmt.Errorf("Error reading CryptoKeyVersion: %s", err)
+	}
+	if err := d.Set("name", res["name"]); err != nil {
+		return fmt.Errorf("Error reading CryptoKeyVersion: %s", err)
+	}
+	if err := d.Set("state", res["state"]); err != nil {
+		return fmt.Errorf("Error reading CryptoKeyVersion: %s", err)
+	}
+	if err := d.Set("algorithm", res["algorithm"]); err != nil {
+		return fmt.Errorf("Error reading CryptoKeyVersion: %s", err)
+	}
+	if err := d.Set("protection_level", res["protectionLevel"]); err != nil {
+		return fmt.Errorf("Error reading CryptoKeyVersion: %s", err)
+	}
+	if err := d.Set("generate_time", res["generateTime"]); err != nil {
+		return fmt.Errorf("Error reading CryptoKeyVersion: %s", err)
+	}
+	if err := d.Set("destroy_time", res["destroyTime"]); err != nil {
+		return fmt.Errorf("Error reading CryptoKeyVersion: %s", err)
+	}
+	if err := d.Set("import_job", res["importJob"]); err != nil {
+		return fmt.Errorf("Error reading CryptoKeyVersion: %s", err)
+	}
+
+	return nil
+}
+
+func resourceKMSCryptoKeyVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("state") {
+		o, n := d.GetChange("state")
+		if err := setKMSCryptoKeyVersionState(d, meta, o.(string), n.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceKMSCryptoKeyVersionRead(d, meta)
+}
+
+func resourceKMSCryptoKeyVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	state := d.Get("state").(string)
+	if state == "DESTROY_SCHEDULED" || state == "DESTROYED" {
+		log.Printf("[DEBUG] CryptoKeyVersion %q is already scheduled for destruction", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf(`
+[WARNING] KMS CryptoKeyVersion resources cannot be deleted from GCP. The CryptoKeyVersion %s will be removed
+from Terraform state and scheduled for destruction, and can be restored until it's destroyed.`, d.Id())
+
+	if err := setKMSCryptoKeyVersionState(d, meta, state, "DESTROY_SCHEDULED", d.Timeout(schema.TimeoutDelete)); err != nil {
+		return handleNotFoundError(err, d, "CryptoKeyVersion")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKMSCryptoKeyVersionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := kmsCryptoKeyVersionIdRegex.FindStringSubmatch(d.Id())
+	if parts == nil {
+		return nil, fmt.Errorf("Invalid CryptoKeyVersion id format, expecting `projects/{projectId}/locations/{locationId}/keyRings/{keyRingName}/cryptoKeys/{cryptoKeyName}/cryptoKeyVersions/{version}`, got id: %s", d.Id())
+	}
+
+	if err := d.Set("crypto_key", parts[1]); err != nil {
+		return nil, fmt.Errorf("Error setting crypto_key: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// kmsCryptoKeyVersionStateActions returns the calls moving a CryptoKeyVersion
+// from one state to another. Restoring a version scheduled for destruction
+// leaves it disabled, so it has to be enabled afterwards.
+func kmsCryptoKeyVersionStateActions(from, to string) []string {
+	switch {
+	case from == to:
+		return nil
+	case to == "DESTROY_SCHEDULED":
+		return []string{"destroy"}
+	case from == "DESTROY_SCHEDULED" && to == "DISABLED":
+		return []string{"restore"}
+	case from == "DESTROY_SCHEDULED":
+		return []string{"restore", "patch"}
+	default:
+		return []string{"patch"}
+	}
+}
+
+func setKMSCryptoKeyVersionState(d *schema.ResourceData, meta interface{}, from, to string, timeout time.Duration) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+	if parts := regexp.MustCompile(`projects\/([^\/]+)\/`).FindStringSubmatch(d.Id()); parts != nil {
+		billingProject = parts[1]
+	}
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	for _, action := range kmsCryptoKeyVersionStateActions(from, to) {
+		method, url, obj := "POST", config.KMSBasePath+d.Id()+":"+action, map[string]interface{}{}
+		if action == "patch" {
+			method = "PATCH"
+			url, err = addQueryParams(config.KMSBasePath+d.Id(), map[string]string{"updateMask": "state"})
+			if err != nil {
+				return err
+			}
+			obj["state"] = to
+		}
+
+		log.Printf("[DEBUG] Updating state of CryptoKeyVersion %q from %s to %s: %s", d.Id(), from, to, action)
+		res, err := sendRequestWithTimeout(config, method, billingProject, url, userAgent, obj, timeout)
+		if err != nil {
+			return fmt.Errorf("Error updating state of CryptoKeyVersion %q to %s: %s", d.Id(), to, err)
+		}
+		log.Printf("[DEBUG] Finished updating state of CryptoKeyVersion %q: %#v", d.Id(), res)
+	}
+
+	return nil
+}
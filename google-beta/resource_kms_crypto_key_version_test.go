@@ -0,0 +1,133 @@
+package google
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestKmsCryptoKeyVersionStateActions(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		From, To string
+		Expected []string
+	}{
+		"unchanged": {
+			From:     "ENABLED",
+			To:       "ENABLED",
+			Expected: nil,
+		},
+		"disable": {
+			From:     "ENABLED",
+			To:       "DISABLED",
+			Expected: []string{"patch"},
+		},
+		"schedule destruction": {
+			From:     "DISABLED",
+			To:       "DESTROY_SCHEDULED",
+			Expected: []string{"destroy"},
+		},
+		"restore disabled": {
+			From:     "DESTROY_SCHEDULED",
+			To:       "DISABLED",
+			Expected: []string{"restore"},
+		},
+		"restore enabled": {
+			From:     "DESTROY_SCHEDULED",
+			To:       "ENABLED",
+			Expected: []string{"restore", "patch"},
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := kmsCryptoKeyVersionStateActions(tc.From, tc.To); !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("bad: %s, expected %v, got %v", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestAccKmsCryptoKeyVersion_restore(t *testing.T) {
+	t.Parallel()
+
+	projectId := fmt.Sprintf("tf-test-%d", randInt(t))
+	projectOrg := getTestOrgFromEnv(t)
+	projectBillingAccount := getTestBillingAccountFromEnv(t)
+	keyRingName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	cryptoKeyName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testGoogleKmsCryptoKeyVersion_state(projectId, projectOrg, projectBillingAccount, keyRingName, cryptoKeyName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_kms_crypto_key_version.crypto_key_version", "version", "2"),
+					resource.TestCheckResourceAttr("google_kms_crypto_key_version.crypto_key_version", "algorithm", "GOOGLE_SYMMETRIC_ENCRYPTION"),
+				),
+			},
+			{
+				ResourceName:      "google_kms_crypto_key_version.crypto_key_version",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testGoogleKmsCryptoKeyVersion_state(projectId, projectOrg, projectBillingAccount, keyRingName, cryptoKeyName, "DESTROY_SCHEDULED"),
+				Check:  resource.TestCheckResourceAttrSet("google_kms_crypto_key_version.crypto_key_version", "destroy_time"),
+			},
+			{
+				Config: testGoogleKmsCryptoKeyVersion_state(projectId, projectOrg, projectBillingAccount, keyRingName, cryptoKeyName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_kms_crypto_key_version.crypto_key_version", "state", "ENABLED"),
+					resource.TestCheckResourceAttr("google_kms_crypto_key_version.crypto_key_version", "destroy_time", ""),
+				),
+			},
+			{
+				ResourceName:      "google_kms_crypto_key_version.crypto_key_version",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Use a separate TestStep rather than a CheckDestroy because we need the project to still exist.
+			{
+				Config: testGoogleKmsCryptoKey_removed(projectId, projectOrg, projectBillingAccount, keyRingName),
+			},
+		},
+	})
+}
+
+// This test runs in its own project, otherwise the test project would start to get filled
+// with undeletable resources
+func testGoogleKmsCryptoKeyVersion_state(projectId, projectOrg, projectBillingAccount, keyRingName, cryptoKeyName, state string) string {
+	return fmt.Sprintf(`
+resource "google_project" "acceptance" {
+  name            = "%s"
+  project_id      = "%s"
+  org_id          = "%s"
+  billing_account = "%s"
+}
+
+resource "google_project_service" "acceptance" {
+  project = google_project.acceptance.project_id
+  service = "cloudkms.googleapis.com"
+}
+
+resource "google_kms_key_ring" "key_ring" {
+  project  = google_project_service.acceptance.project
+  name     = "%s"
+  location = "us-central1"
+}
+
+resource "google_kms_crypto_key" "crypto_key" {
+  name     = "%s"
+  key_ring = google_kms_key_ring.key_ring.id
+}
+
+resource "google_kms_crypto_key_version" "crypto_key_version" {
+  crypto_key = google_kms_crypto_key.crypto_key.id
+  state      = "%s"
+}
+`, projectId, projectId, projectOrg, projectBillingAccount, keyRingName, cryptoKeyName, state)
+}
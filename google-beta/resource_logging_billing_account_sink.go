@@ -43,7 +43,14 @@ func resourceLoggingBillingAccountSinkCreate(d *schema.ResourceData, meta interf
 	}
 
 	d.SetId(id.canonicalId())
-	return resourceLoggingBillingAccountSinkRead(d, meta)
+	if err := resourceLoggingBillingAccountSinkRead(d, meta); err != nil {
+		return err
+	}
+
+	if d.Get("grant_writer_permissions").(bool) {
+		return updateLoggingSinkWriterPermissions(d, config, d.Get("destination").(string), d.Get("writer_identity").(string), true)
+	}
+	return nil
 }
 
 func resourceLoggingBillingAccountSinkRead(d *schema.ResourceData, meta interface{}) error {
@@ -72,6 +79,10 @@ func resourceLoggingBillingAccountSinkUpdate(d *schema.ResourceData, meta interf
 		return err
 	}
 
+	oldDestination, _ := d.GetChange("destination")
+	oldGrant, _ := d.GetChange("grant_writer_permissions")
+	oldWriterIdentity := d.Get("writer_identity").(string)
+
 	sink, updateMask := expandResourceLoggingSinkForUpdate(d)
 
 	// The API will reject any requests that don't explicitly set 'uniqueWriterIdentity' to true.
@@ -81,7 +92,11 @@ func resourceLoggingBillingAccountSinkUpdate(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	return resourceLoggingBillingAccountSinkRead(d, meta)
+	if err := resourceLoggingBillingAccountSinkRead(d, meta); err != nil {
+		return err
+	}
+
+	return resourceLoggingSinkUpdateWriterPermissions(d, config, oldDestination.(string), oldWriterIdentity, oldGrant.(bool))
 }
 
 func resourceLoggingBillingAccountSinkDelete(d *schema.ResourceData, meta interface{}) error {
@@ -91,6 +106,13 @@ func resourceLoggingBillingAccountSinkDelete(d *schema.ResourceData, meta interf
 		return err
 	}
 
+	// The permissions are removed first, so that a failure leaves the sink in place to retry the delete with
+	if d.Get("grant_writer_permissions").(bool) {
+		if err := updateLoggingSinkWriterPermissions(d, config, d.Get("destination").(string), d.Get("writer_identity").(string), false); err != nil {
+			return err
+		}
+	}
+
 	_, err = config.NewLoggingClient(userAgent).Projects.Sinks.Delete(d.Id()).Do()
 	if err != nil {
 		return err
@@ -57,7 +57,14 @@ func resourceLoggingFolderSinkCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.SetId(id.canonicalId())
-	return resourceLoggingFolderSinkRead(d, meta)
+	if err := resourceLoggingFolderSinkRead(d, meta); err != nil {
+		return err
+	}
+
+	if d.Get("grant_writer_permissions").(bool) {
+		return updateLoggingSinkWriterPermissions(d, config, d.Get("destination").(string), d.Get("writer_identity").(string), true)
+	}
+	return nil
 }
 
 func resourceLoggingFolderSinkRead(d *schema.ResourceData, meta interface{}) error {
@@ -90,6 +97,10 @@ func resourceLoggingFolderSinkUpdate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	oldDestination, _ := d.GetChange("destination")
+	oldGrant, _ := d.GetChange("grant_writer_permissions")
+	oldWriterIdentity := d.Get("writer_identity").(string)
+
 	sink, updateMask := expandResourceLoggingSinkForUpdate(d)
 	// It seems the API might actually accept an update for include_children; this is not in the list of updatable
 	// properties though and might break in the future. Always include the value to prevent it changing.
@@ -103,7 +114,11 @@ func resourceLoggingFolderSinkUpdate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	return resourceLoggingFolderSinkRead(d, meta)
+	if err := resourceLoggingFolderSinkRead(d, meta); err != nil {
+		return err
+	}
+
+	return resourceLoggingSinkUpdateWriterPermissions(d, config, oldDestination.(string), oldWriterIdentity, oldGrant.(bool))
 }
 
 func resourceLoggingFolderSinkDelete(d *schema.ResourceData, meta interface{}) error {
@@ -113,6 +128,13 @@ func resourceLoggingFolderSinkDelete(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	// The permissions are removed first, so that a failure leaves the sink in place to retry the delete with
+	if d.Get("grant_writer_permissions").(bool) {
+		if err := updateLoggingSinkWriterPermissions(d, config, d.Get("destination").(string), d.Get("writer_identity").(string), false); err != nil {
+			return err
+		}
+	}
+
 	_, err = config.NewLoggingClient(userAgent).Projects.Sinks.Delete(d.Id()).Do()
 	if err != nil {
 		return err
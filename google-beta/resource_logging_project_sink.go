@@ -62,16 +62,31 @@ func resourceLoggingProjectSinkCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(id.canonicalId())
 
-	return resourceLoggingProjectSinkRead(d, meta)
+	if err := resourceLoggingProjectSinkRead(d, meta); err != nil {
+		return err
+	}
+
+	if d.Get("grant_writer_permissions").(bool) {
+		return updateLoggingSinkWriterPermissions(d, config, d.Get("destination").(string), d.Get("writer_identity").(string), true)
+	}
+	return nil
 }
 
-// if bigquery_options is set unique_writer_identity must be true
+// if bigquery_options or grant_writer_permissions is set unique_writer_identity must be true
 func resourceLoggingProjectSinkCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	// separate func to allow unit testing
 	return resourceLoggingProjectSinkCustomizeDiffFunc(d)
 }
 
 func resourceLoggingProjectSinkCustomizeDiffFunc(diff TerraformResourceDiff) error {
+	// The non-unique writer identity is shared by all the sinks of the project, so its permissions can't be
+	// removed with the sink
+	if grant, _ := diff.Get("grant_writer_permissions").(bool); grant {
+		if uwi, _ := diff.Get("unique_writer_identity").(bool); !uwi {
+			return errors.New("unique_writer_identity must be true when grant_writer_permissions is set")
+		}
+	}
+
 	if !diff.HasChange("bigquery_options.#") {
 		return nil
 	}
@@ -130,6 +145,10 @@ func resourceLoggingProjectSinkUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	oldDestination, _ := d.GetChange("destination")
+	oldGrant, _ := d.GetChange("grant_writer_permissions")
+	oldWriterIdentity := d.Get("writer_identity").(string)
+
 	sink, updateMask := expandResourceLoggingSinkForUpdate(d)
 	uniqueWriterIdentity := d.Get("unique_writer_identity").(bool)
 
@@ -139,7 +158,11 @@ func resourceLoggingProjectSinkUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	return resourceLoggingProjectSinkRead(d, meta)
+	if err := resourceLoggingProjectSinkRead(d, meta); err != nil {
+		return err
+	}
+
+	return resourceLoggingSinkUpdateWriterPermissions(d, config, oldDestination.(string), oldWriterIdentity, oldGrant.(bool))
 }
 
 func resourceLoggingProjectSinkDelete(d *schema.ResourceData, meta interface{}) error {
@@ -149,6 +172,13 @@ func resourceLoggingProjectSinkDelete(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	// The permissions are removed first, so that a failure leaves the sink in place to retry the delete with
+	if d.Get("grant_writer_permissions").(bool) {
+		if err := updateLoggingSinkWriterPermissions(d, config, d.Get("destination").(string), d.Get("writer_identity").(string), false); err != nil {
+			return err
+		}
+	}
+
 	_, err = config.NewLoggingClient(userAgent).Projects.Sinks.Delete(d.Id()).Do()
 	if err != nil {
 		return err
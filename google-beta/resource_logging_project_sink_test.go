@@ -220,8 +220,9 @@ func TestLoggingProjectSink_bigqueryOptionCustomizedDiff(t *testing.T) {
 	t.Parallel()
 
 	type LoggingProjectSink struct {
-		BigqueryOptions      int
-		UniqueWriterIdentity bool
+		BigqueryOptions        int
+		UniqueWriterIdentity   bool
+		GrantWriterPermissions bool
 	}
 	cases := map[string]struct {
 		ExpectedError bool
@@ -255,13 +256,28 @@ func TestLoggingProjectSink_bigqueryOptionCustomizedDiff(t *testing.T) {
 				UniqueWriterIdentity: true,
 			},
 		},
+		"grant writer permissions with false unique writer identity": {
+			ExpectedError: true,
+			After: LoggingProjectSink{
+				UniqueWriterIdentity:   false,
+				GrantWriterPermissions: true,
+			},
+		},
+		"grant writer permissions with true unique writer identity": {
+			ExpectedError: false,
+			After: LoggingProjectSink{
+				UniqueWriterIdentity:   true,
+				GrantWriterPermissions: true,
+			},
+		},
 	}
 
 	for tn, tc := range cases {
 		d := &ResourceDiffMock{
 			After: map[string]interface{}{
-				"bigquery_options.#":     tc.After.BigqueryOptions,
-				"unique_writer_identity": tc.After.UniqueWriterIdentity,
+				"bigquery_options.#":       tc.After.BigqueryOptions,
+				"unique_writer_identity":   tc.After.UniqueWriterIdentity,
+				"grant_writer_permissions": tc.After.GrantWriterPermissions,
 			},
 		}
 		err := resourceLoggingProjectSinkCustomizeDiffFunc(d)
@@ -320,6 +336,39 @@ func TestAccLoggingProjectSink_disabled_update(t *testing.T) {
 	})
 }
 
+func TestAccLoggingProjectSink_grantWriterPermissions(t *testing.T) {
+	t.Parallel()
+
+	sinkName := "tf-test-sink-" + randString(t, 10)
+	bucketName := "tf-test-sink-bucket-" + randString(t, 10)
+	topicName := "tf-test-sink-topic-" + randString(t, 10)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLoggingProjectSinkDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingProjectSink_grantWriterPermissions(sinkName, bucketName, topicName, "storage.googleapis.com/${google_storage_bucket.log-bucket.name}"),
+				Check:  testAccCheckLoggingProjectSinkWriterPermissions(t, "google_logging_project_sink.granted", true),
+			},
+			{
+				ResourceName:            "google_logging_project_sink.granted",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"grant_writer_permissions"},
+			},
+			{
+				Config: testAccLoggingProjectSink_grantWriterPermissions(sinkName, bucketName, topicName, "pubsub.googleapis.com/${google_pubsub_topic.log-topic.id}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggingProjectSinkWriterPermissions(t, "google_logging_project_sink.granted", true),
+					testAccCheckLoggingSinkDestinationIamMember(t, "storage.googleapis.com/"+bucketName, "google_logging_project_sink.granted", false),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLoggingProjectSinkDestroyProducer(t *testing.T) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
 		config := googleProviderConfig(t)
@@ -555,3 +604,70 @@ resource "google_logging_project_sink" "loggingbucket" {
 
 `, name, project, project)
 }
+
+// testAccCheckLoggingProjectSinkWriterPermissions checks whether the writer identity of a sink has the role to
+// write to its destination.
+func testAccCheckLoggingProjectSinkWriterPermissions(t *testing.T, resourceName string, granted bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+		return testAccCheckLoggingSinkDestinationIamMember(t, rs.Primary.Attributes["destination"], resourceName, granted)(s)
+	}
+}
+
+func testAccCheckLoggingSinkDestinationIamMember(t *testing.T, destination, resourceName string, granted bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+		writerIdentity := rs.Primary.Attributes["writer_identity"]
+
+		dest, err := parseLoggingSinkDestination(destination)
+		if err != nil {
+			return err
+		}
+		config := googleProviderConfig(t)
+		d := resourceLoggingProjectSink().TestResourceData()
+		d.SetId(rs.Primary.ID)
+		policy, err := dest.iamUpdater(d, config).GetResourceIamPolicy()
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for _, binding := range policy.Bindings {
+			if binding.Role == dest.writerRole() && binding.Condition == nil {
+				found = found || stringInSlice(binding.Members, writerIdentity)
+			}
+		}
+		if found != granted {
+			return fmt.Errorf("expected %s to have %s on %s: %t, got %t", writerIdentity, dest.writerRole(), destination, granted, found)
+		}
+		return nil
+	}
+}
+
+func testAccLoggingProjectSink_grantWriterPermissions(name, bucketName, topicName, destination string) string {
+	return fmt.Sprintf(`
+resource "google_logging_project_sink" "granted" {
+  name        = "%s"
+  destination = "%s"
+  filter      = "severity>=ERROR"
+
+  unique_writer_identity   = true
+  grant_writer_permissions = true
+}
+
+resource "google_storage_bucket" "log-bucket" {
+  name     = "%s"
+  location = "US"
+}
+
+resource "google_pubsub_topic" "log-topic" {
+  name = "%s"
+}
+`, name, destination, bucketName, topicName)
+}
@@ -2,9 +2,11 @@ package google
 
 import (
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
 	"google.golang.org/api/logging/v2"
 )
 
@@ -73,6 +75,13 @@ func resourceLoggingSinkSchema() map[string]*schema.Schema {
 			},
 		},
 
+		"grant_writer_permissions": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: `Whether to grant the writer_identity of the sink the role it needs to write to the destination: roles/storage.objectCreator on a Cloud Storage bucket, roles/bigquery.dataEditor on a BigQuery dataset, roles/pubsub.publisher on a Pub/Sub topic, or roles/logging.bucketWriter on the project of a log bucket. The role is removed when the sink is destroyed.`,
+		},
+
 		"writer_identity": {
 			Type:        schema.TypeString,
 			Computed:    true,
@@ -248,6 +257,69 @@ func flattenLoggingSinkExclusion(exclusions []*logging.LogExclusion) []map[strin
 	return flattenedExclusions
 }
 
+// updateLoggingSinkWriterPermissions grants or removes the role allowing the writer identity of a sink to write to
+// its destination.
+func updateLoggingSinkWriterPermissions(d TerraformResourceData, config *Config, destination, writerIdentity string, grant bool) error {
+	if writerIdentity == "" {
+		// Sinks writing to a log bucket of their own project don't have a writer identity
+		log.Printf("[DEBUG] Logging sink %s has no writer identity, skipping permissions on %s", d.Id(), destination)
+		return nil
+	}
+
+	dest, err := parseLoggingSinkDestination(destination)
+	if err != nil {
+		return err
+	}
+
+	updater := dest.iamUpdater(d, config)
+	memberBind := &cloudresourcemanager.Binding{
+		Role:    dest.writerRole(),
+		Members: []string{writerIdentity},
+	}
+
+	// iamPolicyReadModifyWrite holds the lock of the destination's policy, which is shared with the IAM resources
+	// of the destination.
+	err = iamPolicyReadModifyWrite(updater, func(ep *cloudresourcemanager.Policy) error {
+		if grant {
+			ep.Bindings = mergeBindings(append(ep.Bindings, memberBind))
+			ep.Version = iamPolicyVersion
+		} else {
+			ep.Bindings = subtractFromBindings(ep.Bindings, memberBind)
+		}
+		return nil
+	})
+	if err != nil {
+		if !grant && isGoogleApiErrorWithCode(err, 404) {
+			log.Printf("[DEBUG] Destination %s of logging sink %s no longer exists", destination, d.Id())
+			return nil
+		}
+		return fmt.Errorf("Error updating %s of %s on %s: %s", memberBind.Role, writerIdentity, updater.DescribeResource(), err)
+	}
+	return nil
+}
+
+// resourceLoggingSinkUpdateWriterPermissions moves the writer permissions of a sink after an update changed its
+// destination, its writer identity or grant_writer_permissions. It is called with the values from before the update,
+// once the sink was read again.
+func resourceLoggingSinkUpdateWriterPermissions(d *schema.ResourceData, config *Config, oldDestination, oldWriterIdentity string, oldGrant bool) error {
+	destination := d.Get("destination").(string)
+	writerIdentity := d.Get("writer_identity").(string)
+	grant := d.Get("grant_writer_permissions").(bool)
+	moved := destination != oldDestination || writerIdentity != oldWriterIdentity
+
+	if oldGrant && (moved || !grant) {
+		if err := updateLoggingSinkWriterPermissions(d, config, oldDestination, oldWriterIdentity, false); err != nil {
+			return err
+		}
+	}
+	if grant && (moved || !oldGrant) {
+		if err := updateLoggingSinkWriterPermissions(d, config, destination, writerIdentity, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func resourceLoggingSinkImportState(sinkType string) schema.StateFunc {
 	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 		loggingSinkId, err := parseLoggingSinkId(d.Id())
@@ -258,6 +330,9 @@ func resourceLoggingSinkImportState(sinkType string) schema.StateFunc {
 		if err := d.Set(sinkType, loggingSinkId.resourceId); err != nil {
 			return nil, fmt.Errorf("Error setting sinkType: %s", err)
 		}
+		if err := d.Set("grant_writer_permissions", false); err != nil {
+			return nil, fmt.Errorf("Error setting grant_writer_permissions: %s", err)
+		}
 
 		return []*schema.ResourceData{d}, nil
 	}
@@ -15,6 +15,7 @@
 package google
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"reflect"
@@ -44,14 +45,16 @@ func resourceMonitoringAlertPolicy() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"combiner": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				ExactlyOneOf: []string{"combiner", "policy_json"},
 				ValidateFunc: validateEnum([]string{"AND", "OR", "AND_WITH_MATCHING_RESOURCE"}),
 				Description: `How to combine the results of multiple conditions to
 determine if an incident should be opened. Possible values: ["AND", "OR", "AND_WITH_MATCHING_RESOURCE"]`,
 			},
 			"conditions": {
-				Type:     schema.TypeList,
-				Required: true,
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"conditions", "policy_json"},
 				Description: `A list of conditions for the policy. The conditions are combined by
 AND or OR according to the combiner field. If the combined conditions
 evaluate to true, then an incident is created. A policy can have from
@@ -714,8 +717,9 @@ policy.`,
 				},
 			},
 			"display_name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"display_name", "policy_json"},
 				Description: `A short name or phrase used to identify the policy in
 dashboards, notifications, and incidents. To avoid confusion, don't use
 the same display name for multiple policies in the same project. The
@@ -813,6 +817,17 @@ can contain only lowercase letters, numerals, underscores, and dashes. Keys
 must begin with a letter.`,
 				Elem: &schema.Schema{Type: schema.TypeString},
 			},
+			"policy_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateMonitoringJsonOrYaml,
+				DiffSuppressFunc: monitoringAlertPolicyJsonNormalizer.diffSuppress(),
+				StateFunc:        normalizeMonitoringJsonOrYaml,
+				ConflictsWith:    []string{"notification_channels", "alert_strategy", "user_labels", "documentation"},
+				Description: `The JSON or YAML representation of the policy, following the format at
+https://cloud.google.com/monitoring/api/ref_v3/rest/v3/projects.alertPolicies,
+as exported from the Cloud Console or gcloud. Conflicts with the other policy fields.`,
+			},
 			"creation_record": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -921,6 +936,10 @@ func resourceMonitoringAlertPolicyCreate(d *schema.ResourceData, meta interface{
 	}
 
 	log.Printf("[DEBUG] Creating new AlertPolicy: %#v", obj)
+	obj, err = resourceMonitoringAlertPolicyEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
 	billingProject := ""
 
 	project, err := getProject(d, config)
@@ -1009,6 +1028,16 @@ func resourceMonitoringAlertPolicyRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("name", flattenMonitoringAlertPolicyName(res["name"], d, config)); err != nil {
 		return fmt.Errorf("Error reading AlertPolicy: %s", err)
 	}
+	if _, ok := d.GetOk("policy_json"); ok {
+		// The policy is managed through policy_json, the other fields aren't in the config
+		if err := d.Set("creation_record", flattenMonitoringAlertPolicyCreationRecord(res["creationRecord"], d, config)); err != nil {
+			return fmt.Errorf("Error reading AlertPolicy: %s", err)
+		}
+		if err := d.Set("policy_json", flattenMonitoringAlertPolicyJson(res)); err != nil {
+			return fmt.Errorf("Error reading AlertPolicy: %s", err)
+		}
+		return nil
+	}
 	if err := d.Set("display_name", flattenMonitoringAlertPolicyDisplayName(res["displayName"], d, config)); err != nil {
 		return fmt.Errorf("Error reading AlertPolicy: %s", err)
 	}
@@ -1151,6 +1180,15 @@ func resourceMonitoringAlertPolicyUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("documentation") {
 		updateMask = append(updateMask, "documentation")
 	}
+
+	obj, err = resourceMonitoringAlertPolicyEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+	if _, ok := d.GetOk("policy_json"); ok {
+		// An empty mask replaces the whole policy with the one from policy_json
+		updateMask = []string{}
+	}
 	// updateMask is a URL parameter but not present in the schema, so replaceVars
 	// won't set it
 	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
@@ -2417,3 +2455,24 @@ func expandMonitoringAlertPolicyDocumentationContent(v interface{}, d TerraformR
 func expandMonitoringAlertPolicyDocumentationMimeType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
+
+func flattenMonitoringAlertPolicyJson(res map[string]interface{}) string {
+	b, err := json.Marshal(monitoringAlertPolicyJsonNormalizer.stripOutputOnly(res, ""))
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func resourceMonitoringAlertPolicyEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	v, ok := d.GetOk("policy_json")
+	if !ok {
+		return obj, nil
+	}
+
+	policy, err := parseMonitoringJsonOrYaml(v.(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing policy_json: %s", err)
+	}
+	return monitoringAlertPolicyJsonNormalizer.stripOutputOnly(policy, "").(map[string]interface{}), nil
+}
@@ -18,6 +18,7 @@ func TestAccMonitoringAlertPolicy(t *testing.T) {
 		"update": testAccMonitoringAlertPolicy_update,
 		"mql":    testAccMonitoringAlertPolicy_mql,
 		"log":    testAccMonitoringAlertPolicy_log,
+		"json":   testAccMonitoringAlertPolicy_json,
 	}
 
 	for name, tc := range testCases {
@@ -156,6 +157,32 @@ func testAccMonitoringAlertPolicy_log(t *testing.T) {
 	})
 }
 
+func testAccMonitoringAlertPolicy_json(t *testing.T) {
+
+	alertName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	conditionName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlertPolicyDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitoringAlertPolicy_jsonCfg(alertName, conditionName, "0.5"),
+				Check:  resource.TestCheckResourceAttrSet("google_monitoring_alert_policy.json", "name"),
+			},
+			{
+				// The server adds the condition names and default values, which mustn't cause a diff
+				Config:   testAccMonitoringAlertPolicy_jsonCfg(alertName, conditionName, "0.5"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccMonitoringAlertPolicy_yamlCfg(alertName, conditionName, "0.8"),
+			},
+		},
+	})
+}
+
 func testAccCheckAlertPolicyDestroyProducer(t *testing.T) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
 		config := googleProviderConfig(t)
@@ -333,3 +360,49 @@ resource "google_monitoring_alert_policy" "log" {
 }
 `, alertName, conditionName)
 }
+
+func testAccMonitoringAlertPolicy_jsonCfg(alertName, conditionName, threshold string) string {
+	return fmt.Sprintf(`
+resource "google_monitoring_alert_policy" "json" {
+  policy_json = jsonencode({
+    displayName = "%s"
+    combiner    = "OR"
+    conditions = [{
+      displayName = "%s"
+      conditionThreshold = {
+        filter         = "metric.type=\"compute.googleapis.com/instance/cpu/utilization\" AND resource.type=\"gce_instance\""
+        comparison     = "COMPARISON_GT"
+        thresholdValue = %s
+        duration       = "60s"
+        aggregations = [{
+          alignmentPeriod  = "60s"
+          perSeriesAligner = "ALIGN_MAX"
+        }]
+      }
+    }]
+  })
+}
+`, alertName, conditionName, threshold)
+}
+
+func testAccMonitoringAlertPolicy_yamlCfg(alertName, conditionName, threshold string) string {
+	return fmt.Sprintf(`
+resource "google_monitoring_alert_policy" "json" {
+  policy_json = <<EOT
+displayName: %s
+combiner: OR
+enabled: true
+conditions:
+- displayName: %s
+  conditionThreshold:
+    filter: metric.type="compute.googleapis.com/instance/cpu/utilization" AND resource.type="gce_instance"
+    comparison: COMPARISON_GT
+    thresholdValue: %s
+    duration: 60s
+    aggregations:
+    - alignmentPeriod: 60s
+      perSeriesAligner: ALIGN_MAX
+EOT
+}
+`, alertName, conditionName, threshold)
+}
@@ -2,7 +2,6 @@ package google
 
 import (
 	"fmt"
-	"reflect"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -11,24 +10,7 @@ import (
 )
 
 func monitoringDashboardDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
-	computedFields := []string{"etag", "name"}
-
-	oldMap, err := structure.ExpandJsonFromString(old)
-	if err != nil {
-		return false
-	}
-
-	newMap, err := structure.ExpandJsonFromString(new)
-	if err != nil {
-		return false
-	}
-
-	for _, f := range computedFields {
-		delete(oldMap, f)
-		delete(newMap, f)
-	}
-
-	return reflect.DeepEqual(oldMap, newMap)
+	return monitoringDashboardJsonNormalizer.diffSuppress()(k, old, new, d)
 }
 
 func resourceMonitoringDashboard() *schema.Resource {
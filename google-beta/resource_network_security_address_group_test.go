@@ -0,0 +1,114 @@
+package google
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestNetworkSecurityAddressGroupItemsDiff(t *testing.T) {
+	cases := map[string]struct {
+		Old, New    []interface{}
+		Add, Remove []string
+	}{
+		"no change": {
+			Old: []interface{}{"10.0.0.0/24", "10.0.1.0/24"},
+			New: []interface{}{"10.0.1.0/24", "10.0.0.0/24"},
+		},
+		"add to empty": {
+			Old: []interface{}{},
+			New: []interface{}{"10.0.0.0/24"},
+			Add: []string{"10.0.0.0/24"},
+		},
+		"remove all": {
+			Old:    []interface{}{"10.0.0.0/24", "10.0.1.0/24"},
+			New:    []interface{}{},
+			Remove: []string{"10.0.0.0/24", "10.0.1.0/24"},
+		},
+		"add and remove": {
+			Old:    []interface{}{"10.0.0.0/24", "10.0.1.0/24"},
+			New:    []interface{}{"10.0.1.0/24", "10.0.2.0/24"},
+			Add:    []string{"10.0.2.0/24"},
+			Remove: []string{"10.0.0.0/24"},
+		},
+	}
+
+	for tn, tc := range cases {
+		add, remove := networkSecurityAddressGroupItemsDiff(schema.NewSet(schema.HashString, tc.Old), schema.NewSet(schema.HashString, tc.New))
+		sort.Strings(add)
+		sort.Strings(remove)
+		if !reflect.DeepEqual(add, tc.Add) {
+			t.Errorf("%s: expected items to add %v, got %v", tn, tc.Add, add)
+		}
+		if !reflect.DeepEqual(remove, tc.Remove) {
+			t.Errorf("%s: expected items to remove %v, got %v", tn, tc.Remove, remove)
+		}
+	}
+}
+
+func TestAccNetworkSecurityAddressGroup_update(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkSecurityAddressGroupDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkSecurityAddressGroup_basic(context),
+			},
+			{
+				ResourceName:      "google_network_security_address_group.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNetworkSecurityAddressGroup_update(context),
+			},
+			{
+				ResourceName:      "google_network_security_address_group.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNetworkSecurityAddressGroup_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_network_security_address_group" "foobar" {
+  name        = "tf-test-address-group%{random_suffix}"
+  parent      = "projects/%{project}"
+  location    = "us-central1"
+  description = "my description"
+  type        = "IPV4"
+  capacity    = "100"
+  items       = ["208.80.154.224/32", "208.80.153.224/32"]
+}
+`, context)
+}
+
+func testAccNetworkSecurityAddressGroup_update(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_network_security_address_group" "foobar" {
+  name        = "tf-test-address-group%{random_suffix}"
+  parent      = "projects/%{project}"
+  location    = "us-central1"
+  description = "my description updated"
+  type        = "IPV4"
+  capacity    = "100"
+  items       = ["208.80.153.224/32", "208.80.155.224/32"]
+  labels      = {
+    foo = "bar"
+  }
+}
+`, context)
+}
@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -27,6 +28,7 @@ func resourcePubsubSchema() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePubsubSchemaCreate,
 		Read:   resourcePubsubSchemaRead,
+		Update: resourcePubsubSchemaUpdate,
 		Delete: resourcePubsubSchemaDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -35,9 +37,14 @@ func resourcePubsubSchema() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.All(
+			resourcePubsubSchemaRevisionCustomizeDiff,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:             schema.TypeString,
@@ -49,10 +56,10 @@ func resourcePubsubSchema() *schema.Resource {
 			"definition": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Description: `The definition of the schema.
 This should contain a string representing the full definition of the schema
-that is a valid schema definition of the type specified in type.`,
+that is a valid schema definition of the type specified in type. Changes to
+the definition are committed as a new revision of the schema.`,
 			},
 			"type": {
 				Type:         schema.TypeString,
@@ -62,6 +69,17 @@ that is a valid schema definition of the type specified in type.`,
 				Description:  `The type of the schema definition Default value: "TYPE_UNSPECIFIED" Possible values: ["TYPE_UNSPECIFIED", "PROTOCOL_BUFFER", "AVRO"]`,
 				Default:      "TYPE_UNSPECIFIED",
 			},
+			"revision_create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `The timestamp that the revision was created.
+A timestamp in RFC3339 UTC "Zulu" format, with nanosecond resolution and up to nine fractional digits.`,
+			},
+			"revision_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The revision ID of the schema.`,
+			},
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -211,10 +229,89 @@ func resourcePubsubSchemaRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("name", flattenPubsubSchemaName(res["name"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Schema: %s", err)
 	}
+	if err := d.Set("revision_id", flattenPubsubSchemaRevisionId(res["revisionId"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Schema: %s", err)
+	}
+	if err := d.Set("revision_create_time", flattenPubsubSchemaRevisionCreateTime(res["revisionCreateTime"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Schema: %s", err)
+	}
 
 	return nil
 }
 
+func resourcePubsubSchemaUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Schema: %s", err)
+	}
+	billingProject = project
+
+	schemaProp := make(map[string]interface{})
+	typeProp, err := expandPubsubSchemaType(d.Get("type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("type"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, typeProp)) {
+		schemaProp["type"] = typeProp
+	}
+	definitionProp, err := expandPubsubSchemaDefinition(d.Get("definition"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("definition"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, definitionProp)) {
+		schemaProp["definition"] = definitionProp
+	}
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	// Going back to the definition of an earlier revision rolls the schema back
+	// to it rather than committing a duplicate revision.
+	revisionId, err := findPubsubSchemaRevisionWithDefinition(d, config, billingProject, userAgent, d.Get("type").(string), d.Get("definition").(string))
+	if err != nil {
+		return err
+	}
+
+	// revision_id is unknown in the plan, so compare with the prior state
+	currentRevisionId, _ := d.GetChange("revision_id")
+	if revisionId != "" && revisionId == currentRevisionId.(string) {
+		log.Printf("[DEBUG] Schema %q already has the configured definition at revision %q", d.Id(), revisionId)
+		return resourcePubsubSchemaRead(d, meta)
+	}
+
+	var url string
+	var obj map[string]interface{}
+	if revisionId != "" {
+		url, err = replaceVars(d, config, "{{PubsubBasePath}}projects/{{project}}/schemas/{{name}}:rollback")
+		obj = map[string]interface{}{"revisionId": revisionId}
+		log.Printf("[DEBUG] Rolling back Schema %q to revision %q", d.Id(), revisionId)
+	} else {
+		url, err = replaceVars(d, config, "{{PubsubBasePath}}projects/{{project}}/schemas/{{name}}:commit")
+		obj = map[string]interface{}{"schema": schemaProp}
+		log.Printf("[DEBUG] Committing Schema %q: %#v", d.Id(), obj)
+	}
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", billingProject, url, userAgent, obj, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("Error updating Schema %q: %s", d.Id(), err)
+	} else {
+		log.Printf("[DEBUG] Finished updating Schema %q: %#v", d.Id(), res)
+	}
+
+	return resourcePubsubSchemaRead(d, meta)
+}
+
 func resourcePubsubSchemaDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	userAgent, err := generateUserAgentString(d, config.userAgent)
@@ -288,6 +385,14 @@ func flattenPubsubSchemaName(v interface{}, d *schema.ResourceData, config *Conf
 	return NameFromSelfLinkStateFunc(v)
 }
 
+func flattenPubsubSchemaRevisionId(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	return v
+}
+
+func flattenPubsubSchemaRevisionCreateTime(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	return v
+}
+
 func expandPubsubSchemaType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
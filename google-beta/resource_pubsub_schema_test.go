@@ -0,0 +1,104 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPubsubSchema_update(t *testing.T) {
+	t.Parallel()
+
+	schema := fmt.Sprintf("tf-test-schema-%s", randString(t, 10))
+	topic := fmt.Sprintf("tf-test-topic-%s", randString(t, 10))
+	revisions := make(map[string]string)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubSchemaDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubSchema_revision(schema, topic, testAccPubsubSchemaDefinitionV1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPubsubSchemaRevision("google_pubsub_schema.foo", revisions, "v1"),
+					resource.TestCheckResourceAttrPair("google_pubsub_topic.foo", "schema_settings.0.first_revision_id", "google_pubsub_schema.foo", "revision_id"),
+				),
+			},
+			{
+				ResourceName:            "google_pubsub_schema.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition"},
+			},
+			{
+				// Committing a new revision keeps the schema, and the topic using it
+				Config: testAccPubsubSchema_revision(schema, topic, testAccPubsubSchemaDefinitionV2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPubsubSchemaRevision("google_pubsub_schema.foo", revisions, "v2"),
+					resource.TestCheckResourceAttrPair("google_pubsub_topic.foo", "schema_settings.0.first_revision_id", "google_pubsub_schema.foo", "revision_id"),
+				),
+			},
+			{
+				ResourceName:      "google_pubsub_topic.foo",
+				ImportStateId:     topic,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Going back to the first definition rolls the schema back
+				Config: testAccPubsubSchema_revision(schema, topic, testAccPubsubSchemaDefinitionV1),
+				Check:  testAccCheckPubsubSchemaRevision("google_pubsub_schema.foo", revisions, "rollback"),
+			},
+		},
+	})
+}
+
+// testAccCheckPubsubSchemaRevision records the revision of the schema under
+// key, and checks that it differs from the revisions recorded before.
+func testAccCheckPubsubSchemaRevision(resourceName string, revisions map[string]string, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		revisionId := rs.Primary.Attributes["revision_id"]
+		if revisionId == "" {
+			return fmt.Errorf("%s has no revision_id", resourceName)
+		}
+		for k, v := range revisions {
+			if v == revisionId {
+				return fmt.Errorf("expected a new revision for %s, got revision %q of %s", key, revisionId, k)
+			}
+		}
+		revisions[key] = revisionId
+		return nil
+	}
+}
+
+const testAccPubsubSchemaDefinitionV1 = `{"type":"record","name":"Avro","fields":[{"name":"StringField","type":"string"}]}`
+
+const testAccPubsubSchemaDefinitionV2 = `{"type":"record","name":"Avro","fields":[{"name":"StringField","type":"string"},{"name":"IntField","type":"int","default":0}]}`
+
+func testAccPubsubSchema_revision(schema, topic, definition string) string {
+	return fmt.Sprintf(`
+resource "google_pubsub_schema" "foo" {
+  name       = "%s"
+  type       = "AVRO"
+  definition = %q
+}
+
+resource "google_pubsub_topic" "foo" {
+  name = "%s"
+
+  schema_settings {
+    schema            = google_pubsub_schema.foo.id
+    encoding          = "JSON"
+    first_revision_id = google_pubsub_schema.foo.revision_id
+  }
+}
+`, schema, definition, topic)
+}
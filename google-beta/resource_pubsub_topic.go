@@ -124,6 +124,20 @@ if the schema has been deleted.`,
 							Description:  `The encoding of messages validated against schema. Default value: "ENCODING_UNSPECIFIED" Possible values: ["ENCODING_UNSPECIFIED", "JSON", "BINARY"]`,
 							Default:      "ENCODING_UNSPECIFIED",
 						},
+						"first_revision_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `The minimum (inclusive) revision allowed for validating messages. If empty
+or not present, allow any revision to be validated against last_revision_id
+or any revision created before.`,
+						},
+						"last_revision_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `The maximum (inclusive) revision allowed for validating messages. If empty
+or not present, allow any revision to be validated against first_revision_id
+or any revision created after.`,
+						},
 					},
 				},
 			},
@@ -524,6 +538,10 @@ func flattenPubsubTopicSchemaSettings(v interface{}, d *schema.ResourceData, con
 		flattenPubsubTopicSchemaSettingsSchema(original["schema"], d, config)
 	transformed["encoding"] =
 		flattenPubsubTopicSchemaSettingsEncoding(original["encoding"], d, config)
+	transformed["first_revision_id"] =
+		flattenPubsubTopicSchemaSettingsFirstRevisionId(original["firstRevisionId"], d, config)
+	transformed["last_revision_id"] =
+		flattenPubsubTopicSchemaSettingsLastRevisionId(original["lastRevisionId"], d, config)
 	return []interface{}{transformed}
 }
 func flattenPubsubTopicSchemaSettingsSchema(v interface{}, d *schema.ResourceData, config *Config) interface{} {
@@ -534,6 +552,14 @@ func flattenPubsubTopicSchemaSettingsEncoding(v interface{}, d *schema.ResourceD
 	return v
 }
 
+func flattenPubsubTopicSchemaSettingsFirstRevisionId(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	return v
+}
+
+func flattenPubsubTopicSchemaSettingsLastRevisionId(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	return v
+}
+
 func flattenPubsubTopicMessageRetentionDuration(v interface{}, d *schema.ResourceData, config *Config) interface{} {
 	return v
 }
@@ -603,6 +629,20 @@ func expandPubsubTopicSchemaSettings(v interface{}, d TerraformResourceData, con
 		transformed["encoding"] = transformedEncoding
 	}
 
+	transformedFirstRevisionId, err := expandPubsubTopicSchemaSettingsFirstRevisionId(original["first_revision_id"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedFirstRevisionId); val.IsValid() && !isEmptyValue(val) {
+		transformed["firstRevisionId"] = transformedFirstRevisionId
+	}
+
+	transformedLastRevisionId, err := expandPubsubTopicSchemaSettingsLastRevisionId(original["last_revision_id"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedLastRevisionId); val.IsValid() && !isEmptyValue(val) {
+		transformed["lastRevisionId"] = transformedLastRevisionId
+	}
+
 	return transformed, nil
 }
 
@@ -614,6 +654,14 @@ func expandPubsubTopicSchemaSettingsEncoding(v interface{}, d TerraformResourceD
 	return v, nil
 }
 
+func expandPubsubTopicSchemaSettingsFirstRevisionId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandPubsubTopicSchemaSettingsLastRevisionId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
 func expandPubsubTopicMessageRetentionDuration(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
@@ -55,13 +55,46 @@ func resourceSecretManagerSecretVersion() *schema.Resource {
 
 		Update: resourceSecretManagerSecretVersionUpdate,
 
+		CustomizeDiff: resourceSecretManagerSecretVersionPayloadCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"secret_data": {
-				Type:        schema.TypeString,
-				Required:    true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  `The secret data. Must be no larger than 64KiB.`,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"secret_data", "secret_data_file", "secret_data_kms_ciphertext"},
+			},
+
+			"is_secret_data_base64": {
+				Type:        schema.TypeBool,
+				Optional:    true,
 				ForceNew:    true,
-				Description: `The secret data. Must be no larger than 64KiB.`,
-				Sensitive:   true,
+				Description: `If set to 'true', 'secret_data' is a base64 encoded string of the payload, for binary payloads.`,
+				Default:     false,
+			},
+			"secret_data_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  `The path of a file containing the secret data, read at apply time. Only the checksum of its content is stored in state.`,
+				ExactlyOneOf: []string{"secret_data", "secret_data_file", "secret_data_kms_ciphertext"},
+			},
+			"secret_data_kms_ciphertext": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  `The secret data encrypted with 'secret_data_kms_crypto_key', base64 encoded. It's decrypted through Cloud KMS at apply time and only its checksum is stored in state.`,
+				ExactlyOneOf: []string{"secret_data", "secret_data_file", "secret_data_kms_ciphertext"},
+				RequiredWith: []string{"secret_data_kms_crypto_key"},
+			},
+			"secret_data_kms_crypto_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  `The id of the Cloud KMS crypto key used to encrypt 'secret_data_kms_ciphertext'.`,
+				RequiredWith: []string{"secret_data_kms_ciphertext"},
 			},
 
 			"secret": {
@@ -93,6 +126,11 @@ func resourceSecretManagerSecretVersion() *schema.Resource {
 				Description: `The resource name of the SecretVersion. Format:
 'projects/{{project}}/secrets/{{secret_id}}/versions/{{version}}'`,
 			},
+			"payload_crc32c": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The CRC32C checksum of the secret data, as a decimal integer.`,
+			},
 		},
 		UseJSONNumber: true,
 	}
@@ -301,6 +339,7 @@ func flattenSecretManagerSecretVersionPayload(v interface{}, d *schema.ResourceD
 	// if this secret version is disabled, the api will return an error, as the value cannot be accessed, return what we have
 	if d.Get("enabled").(bool) == false {
 		transformed["secret_data"] = d.Get("secret_data")
+		transformed["payload_crc32c"] = d.Get("payload_crc32c")
 		return []interface{}{transformed}
 	}
 
@@ -322,11 +361,28 @@ func flattenSecretManagerSecretVersionPayload(v interface{}, d *schema.ResourceD
 		return err
 	}
 
-	data, err := base64.StdEncoding.DecodeString(accessRes["payload"].(map[string]interface{})["data"].(string))
+	payload := accessRes["payload"].(map[string]interface{})
+	data, err := base64.StdEncoding.DecodeString(payload["data"].(string))
 	if err != nil {
 		return err
 	}
-	transformed["secret_data"] = string(data)
+
+	// The payload is only compared through its checksum. It's only stored
+	// when no payload source is known yet, e.g. after an import.
+	if crc, ok := payload["dataCrc32c"].(string); ok {
+		transformed["payload_crc32c"] = crc
+	} else {
+		transformed["payload_crc32c"] = secretManagerSecretVersionPayloadCrc32c(data)
+	}
+	if _, ok := d.GetOk("secret_data"); ok {
+		transformed["secret_data"] = d.Get("secret_data")
+	} else if !secretManagerSecretVersionHasPayloadSource(d) {
+		if d.Get("is_secret_data_base64").(bool) {
+			transformed["secret_data"] = payload["data"]
+		} else {
+			transformed["secret_data"] = string(data)
+		}
+	}
 	return []interface{}{transformed}
 }
 
@@ -365,20 +421,13 @@ func expandSecretManagerSecretVersionEnabled(v interface{}, d TerraformResourceD
 
 func expandSecretManagerSecretVersionPayload(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	transformed := make(map[string]interface{})
-	transformedSecretData, err := expandSecretManagerSecretVersionPayloadSecretData(d.Get("secret_data"), d, config)
+	data, err := secretManagerSecretVersionPayload(d, config)
 	if err != nil {
 		return nil, err
-	} else if val := reflect.ValueOf(transformedSecretData); val.IsValid() && !isEmptyValue(val) {
-		transformed["data"] = transformedSecretData
 	}
 
-	return transformed, nil
-}
+	transformed["data"] = base64.StdEncoding.EncodeToString(data)
+	transformed["dataCrc32c"] = secretManagerSecretVersionPayloadCrc32c(data)
 
-func expandSecretManagerSecretVersionPayloadSecretData(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
-	if v == nil {
-		return nil, nil
-	}
-
-	return base64.StdEncoding.EncodeToString([]byte(v.(string))), nil
+	return transformed, nil
 }
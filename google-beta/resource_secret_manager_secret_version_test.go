@@ -1,6 +1,10 @@
 package google
 
 import (
+	"encoding/base64"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -33,9 +37,9 @@ func TestAccSecretManagerSecretVersion_update(t *testing.T) {
 				ResourceName:      "google_secret_manager_secret_version.secret-version-basic",
 				ImportState:       true,
 				ImportStateVerify: true,
-				// at this point the secret data is disabled and so reading the data on import will
-				// give an empty string
-				ImportStateVerifyIgnore: []string{"secret_data"},
+				// at this point the secret data is disabled and so reading the data or its checksum
+				// on import will give an empty string
+				ImportStateVerifyIgnore: []string{"secret_data", "payload_crc32c"},
 			},
 			{
 				Config: testAccSecretManagerSecretVersion_basic(context),
@@ -49,6 +53,195 @@ func TestAccSecretManagerSecretVersion_update(t *testing.T) {
 	})
 }
 
+func TestSecretManagerSecretVersionLocalPayload(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "payload")
+	if err := ioutil.WriteFile(file, []byte{0xde, 0xad, 0xbe, 0xef}, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		Config      map[string]interface{}
+		Expected    []byte
+		ExpectKnown bool
+		ExpectError bool
+	}{
+		"secret_data": {
+			Config:      map[string]interface{}{"secret_data": "secret"},
+			Expected:    []byte("secret"),
+			ExpectKnown: true,
+		},
+		"base64 secret_data": {
+			Config:      map[string]interface{}{"secret_data": "3q2+7w==", "is_secret_data_base64": true},
+			Expected:    []byte{0xde, 0xad, 0xbe, 0xef},
+			ExpectKnown: true,
+		},
+		"invalid base64 secret_data": {
+			Config:      map[string]interface{}{"secret_data": "not base64!", "is_secret_data_base64": true},
+			ExpectError: true,
+		},
+		"secret_data_file": {
+			Config:      map[string]interface{}{"secret_data_file": file},
+			Expected:    []byte{0xde, 0xad, 0xbe, 0xef},
+			ExpectKnown: true,
+		},
+		"missing secret_data_file": {
+			Config:      map[string]interface{}{"secret_data_file": file + ".missing"},
+			ExpectError: true,
+		},
+		"kms ciphertext": {
+			Config: map[string]interface{}{"secret_data_kms_ciphertext": "Y2lwaGVydGV4dA=="},
+		},
+	}
+
+	for tn, tc := range cases {
+		d := resourceSecretManagerSecretVersion().TestResourceData()
+		for k, v := range tc.Config {
+			if err := d.Set(k, v); err != nil {
+				t.Fatalf("bad: %s, error setting %s: %s", tn, k, err)
+			}
+		}
+
+		data, known, err := secretManagerSecretVersionLocalPayload(d)
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("bad: %s, expected an error", tn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("bad: %s, unexpected error: %s", tn, err)
+			continue
+		}
+		if known != tc.ExpectKnown {
+			t.Errorf("bad: %s, expected known to be %t, got %t", tn, tc.ExpectKnown, known)
+		}
+		if string(data) != string(tc.Expected) {
+			t.Errorf("bad: %s, expected payload %v, got %v", tn, tc.Expected, data)
+		}
+	}
+}
+
+func TestSecretManagerSecretVersionPayloadCrc32c(t *testing.T) {
+	t.Parallel()
+
+	// Check value from RFC 3720
+	if got := secretManagerSecretVersionPayloadCrc32c([]byte("123456789")); got != "3808858755" {
+		t.Errorf("expected the CRC32C checksum to be 3808858755, got %s", got)
+	}
+}
+
+func TestAccSecretManagerSecretVersion_payloadSources(t *testing.T) {
+	// The secret data file is written between steps
+	skipIfVcr(t)
+	t.Parallel()
+
+	kms := BootstrapKMSKey(t)
+	file := filepath.Join(t.TempDir(), "secret")
+	writeSecretFile := func(data string) {
+		if err := ioutil.WriteFile(file, []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeSecretFile("file-secret-v1")
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+		"file":          file,
+		"crypto_key":    kms.CryptoKey.Name,
+		"binary":        base64.StdEncoding.EncodeToString([]byte{0xde, 0xad, 0xbe, 0xef}),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSecretManagerSecretVersionDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretManagerSecretVersion_payloadSources(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_secret_manager_secret_version.file", "payload_crc32c", secretManagerSecretVersionPayloadCrc32c([]byte("file-secret-v1"))),
+					resource.TestCheckNoResourceAttr("google_secret_manager_secret_version.file", "secret_data"),
+					resource.TestCheckResourceAttr("google_secret_manager_secret_version.kms", "payload_crc32c", secretManagerSecretVersionPayloadCrc32c([]byte("kms-secret"))),
+					resource.TestCheckNoResourceAttr("google_secret_manager_secret_version.kms", "secret_data"),
+					resource.TestCheckResourceAttr("google_secret_manager_secret_version.binary", "payload_crc32c", secretManagerSecretVersionPayloadCrc32c([]byte{0xde, 0xad, 0xbe, 0xef})),
+				),
+			},
+			{
+				ResourceName:      "google_secret_manager_secret_version.binary",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the payload is imported as secret_data, which is not base64 encoded by default
+				ImportStateVerifyIgnore: []string{"secret_data", "is_secret_data_base64"},
+			},
+			{
+				PreConfig: func() {
+					writeSecretFile("file-secret-v2")
+				},
+				Config: testAccSecretManagerSecretVersion_payloadSources(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_secret_manager_secret_version.file", "payload_crc32c", secretManagerSecretVersionPayloadCrc32c([]byte("file-secret-v2"))),
+					resource.TestMatchResourceAttr("google_secret_manager_secret_version.file", "name", regexp.MustCompile("/versions/2$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecretManagerSecretVersion_payloadSources(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_secret_manager_secret" "secret-basic" {
+  secret_id = "tf-test-secret-version-%{random_suffix}"
+
+  replication {
+    automatic = true
+  }
+}
+
+resource "google_secret_manager_secret" "secret-kms" {
+  secret_id = "tf-test-secret-version-kms-%{random_suffix}"
+
+  replication {
+    automatic = true
+  }
+}
+
+resource "google_secret_manager_secret" "secret-binary" {
+  secret_id = "tf-test-secret-version-binary-%{random_suffix}"
+
+  replication {
+    automatic = true
+  }
+}
+
+resource "google_secret_manager_secret_version" "file" {
+  secret = google_secret_manager_secret.secret-basic.name
+
+  secret_data_file = "%{file}"
+}
+
+resource "google_kms_secret_ciphertext" "ciphertext" {
+  crypto_key = "%{crypto_key}"
+  plaintext  = "kms-secret"
+}
+
+resource "google_secret_manager_secret_version" "kms" {
+  secret = google_secret_manager_secret.secret-kms.name
+
+  secret_data_kms_ciphertext = google_kms_secret_ciphertext.ciphertext.ciphertext
+  secret_data_kms_crypto_key = "%{crypto_key}"
+}
+
+resource "google_secret_manager_secret_version" "binary" {
+  secret = google_secret_manager_secret.secret-binary.name
+
+  secret_data           = "%{binary}"
+  is_secret_data_base64 = true
+}
+`, context)
+}
+
 func testAccSecretManagerSecretVersion_basic(context map[string]interface{}) string {
 	return Nprintf(`
 resource "google_secret_manager_secret" "secret-basic" {
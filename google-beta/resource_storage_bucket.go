@@ -8,6 +8,7 @@ import (
 	"log"
 	"math"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -33,6 +34,7 @@ func resourceStorageBucket() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			customdiff.ForceNewIfChange("retention_policy.0.is_locked", isPolicyLocked),
+			resourceStorageBucketCustomPlacementConfigCustomizeDiff,
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -368,6 +370,54 @@ func resourceStorageBucket() *schema.Resource {
 				Computed:    true,
 				Description: `Prevents public access to a bucket.`,
 			},
+			"autoclass": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: `While set to true, autoclass automatically transitions objects in your bucket to appropriate storage classes based on each object's access pattern.`,
+						},
+					},
+				},
+				Description: `The bucket's autoclass configuration.`,
+			},
+			"custom_placement_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_locations": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 2,
+							MinItems: 2,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								StateFunc: func(s interface{}) string {
+									return strings.ToUpper(s.(string))
+								},
+							},
+							Set:         storageBucketDataLocationHash,
+							Description: `The list of individual regions that comprise a configurable dual-region bucket. The regions must be part of the bucket's multi-region location.`,
+						},
+					},
+				},
+				Description: `The bucket's custom location configuration, which specifies the individual regions that comprise a dual-region bucket. If the bucket is designated a single or multi-region, the parameters are empty.`,
+			},
+			"rpo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ASYNC_TURBO", "DEFAULT"}, false),
+				Description:  `Specifies the RPO setting of bucket. If set 'ASYNC_TURBO', The Turbo Replication will be enabled for the dual-region bucket. Value 'DEFAULT' will set RPO setting to default. Turbo Replication is only for buckets in dual-regions.`,
+			},
 		},
 		UseJSONNumber: true,
 	}
@@ -469,6 +519,18 @@ func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if v, ok := d.GetOk("autoclass"); ok {
+		sb.Autoclass = expandBucketAutoclass(v)
+	}
+
+	if v, ok := d.GetOk("custom_placement_config"); ok {
+		sb.CustomPlacementConfig = expandBucketCustomPlacementConfig(v)
+	}
+
+	if v, ok := d.GetOk("rpo"); ok {
+		sb.Rpo = v.(string)
+	}
+
 	var res *storage.Bucket
 
 	err = retry(func() error {
@@ -616,6 +678,23 @@ func resourceStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		sb.IamConfiguration = expandIamConfiguration(d)
 	}
 
+	if d.HasChange("autoclass") {
+		if v, ok := d.GetOk("autoclass"); ok {
+			sb.Autoclass = expandBucketAutoclass(v)
+		} else {
+			sb.Autoclass = &storage.BucketAutoclass{
+				Enabled:         false,
+				ForceSendFields: []string{"Enabled"},
+			}
+		}
+	}
+
+	if d.HasChange("rpo") {
+		if v, ok := d.GetOk("rpo"); ok {
+			sb.Rpo = v.(string)
+		}
+	}
+
 	res, err := config.NewStorageClient(userAgent).Buckets.Patch(d.Get("name").(string), sb).Do()
 	if err != nil {
 		return err
@@ -974,6 +1053,137 @@ func flattenBucketVersioning(bucketVersioning *storage.BucketVersioning) []map[s
 	return versionings
 }
 
+func expandBucketAutoclass(configured interface{}) *storage.BucketAutoclass {
+	autoclassList := configured.([]interface{})
+	if len(autoclassList) == 0 || autoclassList[0] == nil {
+		return nil
+	}
+
+	autoclass := autoclassList[0].(map[string]interface{})
+
+	return &storage.BucketAutoclass{
+		Enabled:         autoclass["enabled"].(bool),
+		ForceSendFields: []string{"Enabled"},
+	}
+}
+
+func flattenBucketAutoclass(bucketAutoclass *storage.BucketAutoclass) []map[string]interface{} {
+	autoclassList := make([]map[string]interface{}, 0, 1)
+
+	if bucketAutoclass == nil {
+		return autoclassList
+	}
+
+	autoclass := map[string]interface{}{
+		"enabled": bucketAutoclass.Enabled,
+	}
+	autoclassList = append(autoclassList, autoclass)
+	return autoclassList
+}
+
+func expandBucketCustomPlacementConfig(configured interface{}) *storage.BucketCustomPlacementConfig {
+	configs := configured.([]interface{})
+	if len(configs) == 0 || configs[0] == nil {
+		return nil
+	}
+
+	config := configs[0].(map[string]interface{})
+
+	return &storage.BucketCustomPlacementConfig{
+		DataLocations: expandBucketDataLocations(config["data_locations"]),
+	}
+}
+
+func expandBucketDataLocations(configured interface{}) []string {
+	l := configured.(*schema.Set).List()
+
+	dataLocations := make([]string, 0, len(l))
+	for _, raw := range l {
+		dataLocations = append(dataLocations, strings.ToUpper(raw.(string)))
+	}
+	sort.Strings(dataLocations)
+	return dataLocations
+}
+
+func flattenBucketCustomPlacementConfig(cfc *storage.BucketCustomPlacementConfig) []map[string]interface{} {
+	customPlacementConfigs := make([]map[string]interface{}, 0, 1)
+
+	if cfc == nil || len(cfc.DataLocations) == 0 {
+		return customPlacementConfigs
+	}
+
+	customPlacementConfig := map[string]interface{}{
+		"data_locations": schema.NewSet(storageBucketDataLocationHash, convertStringArrToInterface(cfc.DataLocations)),
+	}
+	customPlacementConfigs = append(customPlacementConfigs, customPlacementConfig)
+	return customPlacementConfigs
+}
+
+// storageBucketDataLocationHash hashes data locations so that region names in a
+// different case than the API returns don't show up as a diff.
+func storageBucketDataLocationHash(v interface{}) int {
+	return schema.HashString(strings.ToUpper(v.(string)))
+}
+
+// The regions that can be paired in a configurable dual-region, keyed by the
+// multi-region the bucket's location is set to.
+// https://cloud.google.com/storage/docs/locations#configurable
+var storageBucketDualRegionDataLocations = map[string][]string{
+	"ASIA": {"ASIA-EAST1", "ASIA-EAST2", "ASIA-NORTHEAST1", "ASIA-NORTHEAST2", "ASIA-NORTHEAST3", "ASIA-SOUTH1", "ASIA-SOUTH2", "ASIA-SOUTHEAST1", "ASIA-SOUTHEAST2"},
+	"EU":   {"EUROPE-CENTRAL2", "EUROPE-NORTH1", "EUROPE-SOUTHWEST1", "EUROPE-WEST1", "EUROPE-WEST3", "EUROPE-WEST4", "EUROPE-WEST8", "EUROPE-WEST9"},
+	"US":   {"US-CENTRAL1", "US-EAST1", "US-EAST4", "US-EAST5", "US-SOUTH1", "US-WEST1", "US-WEST2", "US-WEST3", "US-WEST4"},
+}
+
+// validateBucketCustomPlacementConfig checks that dataLocations are two
+// distinct regions of the location multi-region.
+func validateBucketCustomPlacementConfig(location string, dataLocations []string) error {
+	location = strings.ToUpper(location)
+	regions, ok := storageBucketDualRegionDataLocations[location]
+	if !ok {
+		multiRegions := make([]string, 0, len(storageBucketDualRegionDataLocations))
+		for k := range storageBucketDualRegionDataLocations {
+			multiRegions = append(multiRegions, k)
+		}
+		sort.Strings(multiRegions)
+		return fmt.Errorf("custom_placement_config requires location to be one of the multi-regions %s, got %q", strings.Join(multiRegions, ", "), location)
+	}
+
+	if len(dataLocations) != 2 || strings.EqualFold(dataLocations[0], dataLocations[1]) {
+		return fmt.Errorf("custom_placement_config.data_locations must be two distinct regions, got %v", dataLocations)
+	}
+
+	for _, dl := range dataLocations {
+		if !stringInSlice(regions, strings.ToUpper(dl)) {
+			return fmt.Errorf("custom_placement_config.data_locations %q can't be paired in the %s multi-region, must be one of %s", dl, location, strings.Join(regions, ", "))
+		}
+	}
+	return nil
+}
+
+func resourceStorageBucketCustomPlacementConfigCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("location") || !d.NewValueKnown("custom_placement_config") {
+		return nil
+	}
+
+	v, ok := d.GetOk("custom_placement_config")
+	if !ok {
+		return nil
+	}
+	configs := v.([]interface{})
+	if len(configs) == 0 || configs[0] == nil {
+		return nil
+	}
+	dataLocations := configs[0].(map[string]interface{})["data_locations"].(*schema.Set)
+	for _, dl := range dataLocations.List() {
+		// unknown values in a set of strings are read as empty strings
+		if dl.(string) == "" {
+			return nil
+		}
+	}
+
+	return validateBucketCustomPlacementConfig(d.Get("location").(string), expandBucketDataLocations(dataLocations))
+}
+
 func flattenBucketLifecycle(lifecycle *storage.BucketLifecycle) []map[string]interface{} {
 	if lifecycle == nil || lifecycle.Rule == nil {
 		return []map[string]interface{}{}
@@ -1000,7 +1210,7 @@ func flattenBucketLifecycleRuleAction(action *storage.BucketLifecycleRuleAction)
 
 func flattenBucketLifecycleRuleCondition(condition *storage.BucketLifecycleRuleCondition) map[string]interface{} {
 	ruleCondition := map[string]interface{}{
-		"age":                        int(condition.Age),
+		"age":                        0,
 		"created_before":             condition.CreatedBefore,
 		"matches_storage_class":      convertStringArrToInterface(condition.MatchesStorageClass),
 		"num_newer_versions":         int(condition.NumNewerVersions),
@@ -1011,6 +1221,9 @@ func flattenBucketLifecycleRuleCondition(condition *storage.BucketLifecycleRuleC
 		"matches_prefix":             convertStringArrToInterface(condition.MatchesPrefix),
 		"matches_suffix":             convertStringArrToInterface(condition.MatchesSuffix),
 	}
+	if condition.Age != nil {
+		ruleCondition["age"] = int(*condition.Age)
+	}
 	if condition.IsLive == nil {
 		ruleCondition["with_state"] = "ANY"
 	} else {
@@ -1168,8 +1381,11 @@ func expandStorageBucketLifecycleRuleCondition(v interface{}) (*storage.BucketLi
 	condition := conditions[0].(map[string]interface{})
 	transformed := &storage.BucketLifecycleRuleCondition{}
 
-	if v, ok := condition["age"]; ok {
-		transformed.Age = int64(v.(int))
+	// An unset age is read as 0 from the schema, and must not be sent as an
+	// explicit age of 0 days, which would match every object.
+	if v, ok := condition["age"]; ok && v.(int) > 0 {
+		age := int64(v.(int))
+		transformed.Age = &age
 	}
 
 	if v, ok := condition["created_before"]; ok {
@@ -1437,6 +1653,24 @@ func setStorageBucket(d *schema.ResourceData, config *Config, res *storage.Bucke
 		}
 	}
 
+	// Disabling autoclass leaves its configuration on the bucket, only keep
+	// it in state when it's enabled or explicitly configured as disabled.
+	if res.Autoclass != nil && (res.Autoclass.Enabled || len(d.Get("autoclass").([]interface{})) > 0) {
+		if err := d.Set("autoclass", flattenBucketAutoclass(res.Autoclass)); err != nil {
+			return fmt.Errorf("Error setting autoclass: %s", err)
+		}
+	} else {
+		if err := d.Set("autoclass", nil); err != nil {
+			return fmt.Errorf("Error setting autoclass: %s", err)
+		}
+	}
+	if err := d.Set("custom_placement_config", flattenBucketCustomPlacementConfig(res.CustomPlacementConfig)); err != nil {
+		return fmt.Errorf("Error setting custom_placement_config: %s", err)
+	}
+	if err := d.Set("rpo", res.Rpo); err != nil {
+		return fmt.Errorf("Error setting rpo: %s", err)
+	}
+
 	if res.Billing == nil {
 		if err := d.Set("requester_pays", nil); err != nil {
 			return fmt.Errorf("Error setting requester_pays: %s", err)
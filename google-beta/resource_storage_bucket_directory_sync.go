@@ -0,0 +1,463 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gammazero/workerpool"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"google.golang.org/api/storage/v1"
+)
+
+func resourceStorageBucketDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageBucketDirectorySyncCreate,
+		Read:   resourceStorageBucketDirectorySyncRead,
+		Update: resourceStorageBucketDirectorySyncUpdate,
+		Delete: resourceStorageBucketDirectorySyncDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceStorageBucketDirectorySyncImport,
+		},
+
+		CustomizeDiff: resourceStorageBucketDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The name of the bucket to sync the directory to.`,
+			},
+
+			"source_dir": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The local directory to sync.`,
+			},
+
+			"prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStorageDirectorySyncPrefix,
+				Description:  `The folder, ending with a "/", prepended to the path of each file to form its object name. Every object under the prefix is managed by this resource.`,
+			},
+
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Metadata to set on the objects uploaded from files matching a pattern. The first matching rule applies.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `A glob matched against the file name, or against its path relative to source_dir when the pattern contains a "/".`,
+						},
+						"content_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Content-Type of the objects.`,
+						},
+						"cache_control": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Cache-Control directive of the objects.`,
+						},
+					},
+				},
+			},
+
+			"files": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The base64 encoded MD5 hash of each synced file, keyed by its path relative to source_dir.`,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+// validateStorageDirectorySyncPrefix requires the prefix to be a folder, as
+// every object under it is deleted unless it matches a local file: "app" would
+// also match the objects of "app2/" and "app-backup/", and an empty prefix
+// every object of the bucket.
+func validateStorageDirectorySyncPrefix(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" || value == "/" {
+		errors = append(errors, fmt.Errorf("%q must not be empty or the root of the bucket", k))
+		return
+	}
+	if !strings.HasSuffix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q must end with a \"/\", got %q", k, value))
+	}
+	if strings.HasPrefix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q must not start with a \"/\", got %q", k, value))
+	}
+	return
+}
+
+type storageDirectorySyncRule struct {
+	pattern      string
+	contentType  string
+	cacheControl string
+}
+
+func expandStorageDirectorySyncRules(v interface{}) []storageDirectorySyncRule {
+	var rules []storageDirectorySyncRule
+	for _, raw := range v.([]interface{}) {
+		if raw == nil {
+			continue
+		}
+		r := raw.(map[string]interface{})
+		rules = append(rules, storageDirectorySyncRule{
+			pattern:      r["pattern"].(string),
+			contentType:  r["content_type"].(string),
+			cacheControl: r["cache_control"].(string),
+		})
+	}
+	return rules
+}
+
+// storageDirectorySyncObjectMetadata returns the metadata of the object
+// uploaded from relPath. Without a matching content_type, it's inferred from
+// the file extension.
+func storageDirectorySyncObjectMetadata(relPath string, rules []storageDirectorySyncRule) (string, string) {
+	contentType := mime.TypeByExtension(path.Ext(relPath))
+	for _, rule := range rules {
+		target := path.Base(relPath)
+		if strings.Contains(rule.pattern, "/") {
+			target = relPath
+		}
+		if matched, _ := path.Match(rule.pattern, target); !matched {
+			continue
+		}
+		if rule.contentType != "" {
+			contentType = rule.contentType
+		}
+		return contentType, rule.cacheControl
+	}
+	return contentType, ""
+}
+
+// listStorageDirectorySyncFiles returns the md5 hash of every regular file in
+// dir, keyed by its slash separated path relative to dir.
+func listStorageDirectorySyncFiles(dir string) (map[string]string, error) {
+	files := make(map[string]string)
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		hash := getFileMd5Hash(p)
+		if hash == "" {
+			return fmt.Errorf("unable to read %q", p)
+		}
+		files[filepath.ToSlash(rel)] = hash
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading source_dir %q: %s", dir, err)
+	}
+	return files, nil
+}
+
+// listStorageDirectorySyncObjects returns the objects under prefix, keyed by
+// their name relative to prefix.
+func listStorageDirectorySyncObjects(config *Config, userAgent, bucket, prefix string) (map[string]*storage.Object, error) {
+	objects := make(map[string]*storage.Object)
+	err := config.NewStorageClient(userAgent).Objects.List(bucket).Prefix(prefix).Pages(context.Background(), func(res *storage.Objects) error {
+		for _, o := range res.Items {
+			rel := strings.TrimPrefix(o.Name, prefix)
+			if rel == "" || strings.HasSuffix(rel, "/") {
+				// placeholder objects created by the console for folders
+				continue
+			}
+			objects[rel] = o
+		}
+		return nil
+	})
+	return objects, err
+}
+
+// storageDirectorySyncObjectHash returns the md5 hash of an object to compare
+// to the local file. Composite objects don't have an md5 hash, so the local
+// file's hash is used instead when their crc32c checksums match.
+func storageDirectorySyncObjectHash(o *storage.Object, localPath string) string {
+	if o.Md5Hash != "" {
+		return o.Md5Hash
+	}
+	if localPath != "" && o.Crc32c != "" && getFileCrc32cHash(localPath) == o.Crc32c {
+		return getFileMd5Hash(localPath)
+	}
+	return o.Crc32c
+}
+
+// runStorageDirectorySyncTasks runs tasks in parallel with the same worker pool
+// sizing as a bucket's force_destroy, returning every error that occurred.
+func runStorageDirectorySyncTasks(tasks []func() error) error {
+	var mu sync.Mutex
+	var errs []string
+
+	wp := workerpool.New(runtime.NumCPU() - 1)
+	for _, task := range tasks {
+		task := task
+		wp.Submit(func() {
+			if err := task(); err != nil {
+				mu.Lock()
+				errs = append(errs, err.Error())
+				mu.Unlock()
+			}
+		})
+	}
+	wp.StopWait()
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("%d error(s) occurred:\n\t%s", len(errs), strings.Join(errs, "\n\t"))
+	}
+	return nil
+}
+
+func resourceStorageBucketDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") {
+		return d.SetNewComputed("files")
+	}
+
+	files, err := listStorageDirectorySyncFiles(d.Get("source_dir").(string))
+	if err != nil {
+		return err
+	}
+
+	old := d.Get("files").(map[string]interface{})
+	changed := len(old) != len(files)
+	for k, v := range files {
+		if old[k] != v {
+			changed = true
+			break
+		}
+	}
+	if changed {
+		return d.SetNew("files", files)
+	}
+	return nil
+}
+
+func resourceStorageBucketDirectorySyncCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	if err := syncStorageBucketDirectory(d, config, userAgent, false); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("bucket").(string), d.Get("prefix").(string)))
+
+	return resourceStorageBucketDirectorySyncRead(d, meta)
+}
+
+func resourceStorageBucketDirectorySyncUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	if err := syncStorageBucketDirectory(d, config, userAgent, d.HasChange("rule")); err != nil {
+		return err
+	}
+
+	return resourceStorageBucketDirectorySyncRead(d, meta)
+}
+
+// syncStorageBucketDirectory uploads new and changed files, and deletes the
+// objects under the prefix that no longer have a matching file. When
+// updateMetadata is set, the metadata of unchanged objects is updated to
+// match the rules.
+func syncStorageBucketDirectory(d *schema.ResourceData, config *Config, userAgent string, updateMetadata bool) error {
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	dir := d.Get("source_dir").(string)
+	rules := expandStorageDirectorySyncRules(d.Get("rule"))
+
+	files, err := listStorageDirectorySyncFiles(dir)
+	if err != nil {
+		return err
+	}
+	objects, err := listStorageDirectorySyncObjects(config, userAgent, bucket, prefix)
+	if err != nil {
+		return fmt.Errorf("Error listing objects in bucket %q under %q: %s", bucket, prefix, err)
+	}
+
+	var tasks []func() error
+	for rel, hash := range files {
+		rel := rel
+		name := prefix + rel
+		localPath := filepath.Join(dir, filepath.FromSlash(rel))
+		contentType, cacheControl := storageDirectorySyncObjectMetadata(rel, rules)
+
+		o, exists := objects[rel]
+		if !exists || storageDirectorySyncObjectHash(o, localPath) != hash {
+			tasks = append(tasks, func() error {
+				log.Printf("[TRACE] Uploading %q to gs://%s/%s", localPath, bucket, name)
+				f, err := os.Open(localPath)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+
+				object := &storage.Object{
+					Name:         name,
+					ContentType:  contentType,
+					CacheControl: cacheControl,
+				}
+				if _, err := config.NewStorageClient(userAgent).Objects.Insert(bucket, object).Media(f).Do(); err != nil {
+					return fmt.Errorf("Error uploading %q: %s", name, err)
+				}
+				return nil
+			})
+			continue
+		}
+
+		if updateMetadata && (o.ContentType != contentType || o.CacheControl != cacheControl) {
+			tasks = append(tasks, func() error {
+				log.Printf("[TRACE] Updating metadata of gs://%s/%s", bucket, name)
+				object := &storage.Object{
+					ContentType:     contentType,
+					CacheControl:    cacheControl,
+					ForceSendFields: []string{"ContentType", "CacheControl"},
+				}
+				if _, err := config.NewStorageClient(userAgent).Objects.Patch(bucket, name, object).Do(); err != nil {
+					return fmt.Errorf("Error updating metadata of %q: %s", name, err)
+				}
+				return nil
+			})
+		}
+	}
+
+	for rel, o := range objects {
+		if _, ok := files[rel]; ok {
+			continue
+		}
+		o := o
+		tasks = append(tasks, func() error {
+			log.Printf("[TRACE] Deleting gs://%s/%s", bucket, o.Name)
+			if err := config.NewStorageClient(userAgent).Objects.Delete(bucket, o.Name).Do(); err != nil && !isGoogleApiErrorWithCode(err, 404) {
+				return fmt.Errorf("Error deleting %q: %s", o.Name, err)
+			}
+			return nil
+		})
+	}
+
+	log.Printf("[DEBUG] Syncing %q to gs://%s/%s with %d operation(s)", dir, bucket, prefix, len(tasks))
+	return runStorageDirectorySyncTasks(tasks)
+}
+
+func resourceStorageBucketDirectorySyncRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	dir := d.Get("source_dir").(string)
+
+	objects, err := listStorageDirectorySyncObjects(config, userAgent, bucket, prefix)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Storage Bucket Directory Sync %q", d.Id()))
+	}
+
+	files := make(map[string]string)
+	for rel, o := range objects {
+		localPath := ""
+		if dir != "" {
+			localPath = filepath.Join(dir, filepath.FromSlash(rel))
+			if _, err := os.Stat(localPath); err != nil {
+				localPath = ""
+			}
+		}
+		files[rel] = storageDirectorySyncObjectHash(o, localPath)
+	}
+	if err := d.Set("files", files); err != nil {
+		return fmt.Errorf("Error setting files: %s", err)
+	}
+
+	return nil
+}
+
+func resourceStorageBucketDirectorySyncDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	objects, err := listStorageDirectorySyncObjects(config, userAgent, bucket, prefix)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Storage Bucket Directory Sync %q", d.Id()))
+	}
+
+	var tasks []func() error
+	for _, o := range objects {
+		o := o
+		tasks = append(tasks, func() error {
+			if err := config.NewStorageClient(userAgent).Objects.Delete(bucket, o.Name).Do(); err != nil && !isGoogleApiErrorWithCode(err, 404) {
+				return fmt.Errorf("Error deleting %q: %s", o.Name, err)
+			}
+			return nil
+		})
+	}
+	if err := runStorageDirectorySyncTasks(tasks); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceStorageBucketDirectorySyncImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The prefix contains slashes itself, so only the first one separates it
+	// from the bucket
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid storage bucket directory sync specifier. Expecting {bucket}/{prefix}, got %s", d.Id())
+	}
+	if _, errs := validateStorageDirectorySyncPrefix(parts[1], "prefix"); len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	if err := d.Set("bucket", parts[0]); err != nil {
+		return nil, fmt.Errorf("Error setting bucket: %s", err)
+	}
+	if err := d.Set("prefix", parts[1]); err != nil {
+		return nil, fmt.Errorf("Error setting prefix: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,274 @@
+package google
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"google.golang.org/api/storage/v1"
+)
+
+func TestStorageDirectorySyncObjectMetadata(t *testing.T) {
+	t.Parallel()
+
+	rules := []storageDirectorySyncRule{
+		{pattern: "assets/*", cacheControl: "public, max-age=31536000"},
+		{pattern: "*.html", contentType: "text/html; charset=utf-8", cacheControl: "no-cache"},
+		{pattern: "*.wasm", contentType: "application/wasm"},
+	}
+
+	cases := map[string]struct {
+		Path                 string
+		ExpectedContentType  string
+		ExpectedCacheControl string
+	}{
+		"basename match": {
+			Path:                 "blog/index.html",
+			ExpectedContentType:  "text/html; charset=utf-8",
+			ExpectedCacheControl: "no-cache",
+		},
+		"relative path match keeps inferred content type": {
+			Path:                 "assets/app.css",
+			ExpectedContentType:  "text/css; charset=utf-8",
+			ExpectedCacheControl: "public, max-age=31536000",
+		},
+		"first matching rule wins": {
+			Path:                 "assets/index.html",
+			ExpectedContentType:  "text/html; charset=utf-8",
+			ExpectedCacheControl: "public, max-age=31536000",
+		},
+		"relative path patterns don't match nested directories": {
+			Path:                 "assets/img/logo.wasm",
+			ExpectedContentType:  "application/wasm",
+			ExpectedCacheControl: "",
+		},
+		"no match": {
+			Path:                 "robots",
+			ExpectedContentType:  "",
+			ExpectedCacheControl: "",
+		},
+	}
+
+	for tn, tc := range cases {
+		contentType, cacheControl := storageDirectorySyncObjectMetadata(tc.Path, rules)
+		if contentType != tc.ExpectedContentType {
+			t.Errorf("bad: %s, expected content type %q, got %q", tn, tc.ExpectedContentType, contentType)
+		}
+		if cacheControl != tc.ExpectedCacheControl {
+			t.Errorf("bad: %s, expected cache control %q, got %q", tn, tc.ExpectedCacheControl, cacheControl)
+		}
+	}
+}
+
+func TestValidateStorageDirectorySyncPrefix(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]bool{
+		"site/":        true,
+		"site/assets/": true,
+		"":             false,
+		"/":            false,
+		"site":         false,
+		"/site/":       false,
+	}
+
+	for prefix, valid := range cases {
+		_, errs := validateStorageDirectorySyncPrefix(prefix, "prefix")
+		if valid && len(errs) > 0 {
+			t.Errorf("expected prefix %q to be valid, got %v", prefix, errs)
+		}
+		if !valid && len(errs) == 0 {
+			t.Errorf("expected prefix %q to be invalid", prefix)
+		}
+	}
+}
+
+func TestListStorageDirectorySyncFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeStorageDirectorySyncFiles(t, dir, map[string]string{
+		"index.html":       "<html></html>",
+		"assets/app.css":   "body {}",
+		"assets/img/empty": "",
+	})
+
+	files, err := listStorageDirectorySyncFiles(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	expected := []string{"assets/app.css", "assets/img/empty", "index.html"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected files %v, got %v", expected, names)
+	}
+	if files["index.html"] != getFileMd5Hash(filepath.Join(dir, "index.html")) {
+		t.Errorf("expected the md5 hash of index.html, got %q", files["index.html"])
+	}
+
+	if _, err := listStorageDirectorySyncFiles(filepath.Join(dir, "missing")); err == nil {
+		t.Errorf("expected an error listing a missing directory")
+	}
+}
+
+func TestStorageDirectorySyncObjectHash(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeStorageDirectorySyncFiles(t, dir, map[string]string{"file": "composed"})
+	localPath := filepath.Join(dir, "file")
+	md5 := getFileMd5Hash(localPath)
+	crc32c := getFileCrc32cHash(localPath)
+
+	cases := map[string]struct {
+		Object   *storage.Object
+		Expected string
+	}{
+		"md5": {
+			Object:   &storage.Object{Md5Hash: "remote", Crc32c: crc32c},
+			Expected: "remote",
+		},
+		"composite object matching the local file": {
+			Object:   &storage.Object{Crc32c: crc32c},
+			Expected: md5,
+		},
+		"composite object not matching the local file": {
+			Object:   &storage.Object{Crc32c: "AAAAAA=="},
+			Expected: "AAAAAA==",
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := storageDirectorySyncObjectHash(tc.Object, localPath); got != tc.Expected {
+			t.Errorf("bad: %s, expected %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestAccStorageBucketDirectorySync_basic(t *testing.T) {
+	// Files are written to a local directory between steps
+	skipIfVcr(t)
+	t.Parallel()
+
+	bucketName := testBucketName(t)
+	dir := t.TempDir()
+	writeStorageDirectorySyncFiles(t, dir, map[string]string{
+		"index.html":     "<html>v1</html>",
+		"assets/app.css": "body {}",
+		"stale.txt":      "to be removed",
+	})
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketDirectorySync(bucketName, dir, "no-cache"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_storage_bucket_directory_sync.site", "files.%", "3"),
+					testAccCheckStorageBucketDirectorySyncObject(t, bucketName, "site/index.html", "text/html; charset=utf-8", "no-cache"),
+					testAccCheckStorageBucketDirectorySyncObject(t, bucketName, "site/stale.txt", "text/plain; charset=utf-8", ""),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeStorageDirectorySyncFiles(t, dir, map[string]string{"index.html": "<html>v2</html>"})
+					if err := os.Remove(filepath.Join(dir, "stale.txt")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccStorageBucketDirectorySync(bucketName, dir, "public, max-age=60"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_storage_bucket_directory_sync.site", "files.%", "2"),
+					testAccCheckStorageBucketDirectorySyncObject(t, bucketName, "site/index.html", "text/html; charset=utf-8", "public, max-age=60"),
+					testAccCheckStorageBucketDirectorySyncObjectMissing(t, bucketName, "site/stale.txt"),
+				),
+			},
+			{
+				ResourceName:      "google_storage_bucket_directory_sync.site",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The local directory and the rules used to upload files can't be read back
+				ImportStateVerifyIgnore: []string{"source_dir", "rule"},
+			},
+		},
+	})
+}
+
+func writeStorageDirectorySyncFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccCheckStorageBucketDirectorySyncObject(t *testing.T, bucket, name, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := googleProviderConfig(t)
+
+		o, err := config.NewStorageClient(config.userAgent).Objects.Get(bucket, name).Do()
+		if err != nil {
+			return fmt.Errorf("Error retrieving object %q: %s", name, err)
+		}
+		if o.ContentType != contentType {
+			return fmt.Errorf("expected object %q to have content type %q, got %q", name, contentType, o.ContentType)
+		}
+		if o.CacheControl != cacheControl {
+			return fmt.Errorf("expected object %q to have cache control %q, got %q", name, cacheControl, o.CacheControl)
+		}
+		return nil
+	}
+}
+
+func testAccCheckStorageBucketDirectorySyncObjectMissing(t *testing.T, bucket, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := googleProviderConfig(t)
+
+		_, err := config.NewStorageClient(config.userAgent).Objects.Get(bucket, name).Do()
+		if err == nil {
+			return fmt.Errorf("expected object %q to be deleted", name)
+		}
+		if !isGoogleApiErrorWithCode(err, 404) {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccStorageBucketDirectorySync(bucketName, dir, htmlCacheControl string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name          = "%s"
+  location      = "US"
+  force_destroy = true
+}
+
+resource "google_storage_bucket_directory_sync" "site" {
+  bucket     = google_storage_bucket.bucket.name
+  prefix     = "site/"
+  source_dir = "%s"
+
+  rule {
+    pattern       = "*.html"
+    cache_control = "%s"
+  }
+}
+`, bucketName, dir, htmlCacheControl)
+}
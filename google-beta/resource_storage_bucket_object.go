@@ -14,6 +14,8 @@ import (
 	"crypto/md5"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
+	"hash/crc32"
 	"io/ioutil"
 	"net/http"
 
@@ -521,6 +523,19 @@ func getContentMd5Hash(content []byte) string {
 	return base64.StdEncoding.EncodeToString(h.Sum(nil))
 }
 
+// getFileCrc32cHash returns the base64 encoded, big-endian CRC32C checksum
+// of a file, as reported by GCS for objects without an md5 hash.
+func getFileCrc32cHash(filename string) string {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		log.Printf("[WARN] Failed to read source file %q. Cannot compute crc32c hash for it.", filename)
+		return ""
+	}
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli)))
+	return base64.StdEncoding.EncodeToString(b)
+}
+
 func expandCustomerEncryption(input []interface{}) map[string]string {
 	expanded := make(map[string]string)
 	if input == nil {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"google.golang.org/api/googleapi"
@@ -977,6 +978,158 @@ func TestAccStorageBucket_retentionPolicyLocked(t *testing.T) {
 	})
 }
 
+func TestAccStorageBucket_autoclass(t *testing.T) {
+	t.Parallel()
+
+	bucketName := testBucketName(t)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_autoclass(bucketName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "autoclass.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			{
+				Config: testAccStorageBucket_autoclass(bucketName, false),
+			},
+			{
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			{
+				Config: testAccStorageBucket_basic(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "autoclass.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageBucket_dualRegionTurboReplication(t *testing.T) {
+	t.Parallel()
+
+	var bucket storage.Bucket
+	var updated storage.Bucket
+	bucketName := testBucketName(t)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_dualRegion(bucketName, "DEFAULT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(
+						t, "google_storage_bucket.bucket", bucketName, &bucket),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "custom_placement_config.0.data_locations.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			{
+				Config: testAccStorageBucket_dualRegion(bucketName, "ASYNC_TURBO"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(
+						t, "google_storage_bucket.bucket", bucketName, &updated),
+					testAccCheckStorageBucketWasUpdated(&updated, &bucket),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "rpo", "ASYNC_TURBO"),
+				),
+			},
+			{
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccStorageBucket_customPlacementConfigInvalid(t *testing.T) {
+	t.Parallel()
+
+	bucketName := testBucketName(t)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStorageBucket_customPlacementConfig(bucketName, "EU", "us-central1", "europe-west1"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`can't be paired in the EU multi-region`),
+			},
+		},
+	})
+}
+
+func TestStorageBucketCustomPlacementConfigValidation(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Location      string
+		DataLocations []string
+		ExpectError   bool
+	}{
+		"valid pair": {
+			Location:      "US",
+			DataLocations: []string{"US-CENTRAL1", "US-EAST1"},
+		},
+		"lowercase": {
+			Location:      "eu",
+			DataLocations: []string{"europe-west1", "europe-north1"},
+		},
+		"region location": {
+			Location:      "US-CENTRAL1",
+			DataLocations: []string{"US-CENTRAL1", "US-EAST1"},
+			ExpectError:   true,
+		},
+		"region outside the multi-region": {
+			Location:      "ASIA",
+			DataLocations: []string{"ASIA-EAST1", "US-EAST1"},
+			ExpectError:   true,
+		},
+		"same region twice": {
+			Location:      "US",
+			DataLocations: []string{"US-EAST1", "us-east1"},
+			ExpectError:   true,
+		},
+	}
+
+	for tn, tc := range cases {
+		err := validateBucketCustomPlacementConfig(tc.Location, tc.DataLocations)
+		if tc.ExpectError && err == nil {
+			t.Errorf("bad: %s, expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("bad: %s, unexpected error: %s", tn, err)
+		}
+	}
+}
+
 func testAccCheckStorageBucketExists(t *testing.T, n string, bucketName string, bucket *storage.Bucket) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1768,3 +1921,86 @@ resource "google_storage_bucket" "bucket" {
 }
 `, bucketName)
 }
+
+func testAccStorageBucket_autoclass(bucketName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name          = "%s"
+  location      = "US"
+  force_destroy = true
+
+  autoclass {
+    enabled = %t
+  }
+}
+`, bucketName, enabled)
+}
+
+func testAccStorageBucket_dualRegion(bucketName, rpo string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name          = "%s"
+  location      = "US"
+  force_destroy = true
+  rpo           = "%s"
+
+  custom_placement_config {
+    data_locations = ["us-central1", "US-EAST1"]
+  }
+}
+`, bucketName, rpo)
+}
+
+func testAccStorageBucket_customPlacementConfig(bucketName, location, region1, region2 string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name          = "%s"
+  location      = "%s"
+  force_destroy = true
+
+  custom_placement_config {
+    data_locations = ["%s", "%s"]
+  }
+}
+`, bucketName, location, region1, region2)
+}
+
+func TestExpandStorageBucketLifecycleRuleConditionAge(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Age      int
+		Expected *int64
+	}{
+		"unset": {
+			Age:      0,
+			Expected: nil,
+		},
+		"set": {
+			Age:      10,
+			Expected: googleapi.Int64(10),
+		},
+	}
+
+	for tn, tc := range cases {
+		conditions := schema.NewSet(resourceGCSBucketLifecycleRuleConditionHash, []interface{}{
+			map[string]interface{}{
+				"age":        tc.Age,
+				"with_state": "ANY",
+			},
+		})
+		condition, err := expandStorageBucketLifecycleRuleCondition(conditions)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+		if tc.Expected == nil {
+			if condition.Age != nil {
+				t.Errorf("%s: expected no age to be sent, got %d", tn, *condition.Age)
+			}
+			continue
+		}
+		if condition.Age == nil || *condition.Age != *tc.Expected {
+			t.Errorf("%s: expected age %d, got %v", tn, *tc.Expected, condition.Age)
+		}
+	}
+}
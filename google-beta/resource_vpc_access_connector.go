@@ -39,6 +39,8 @@ func resourceVPCAccessConnector() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: resourceVPCAccessConnectorCidrOverlapCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -0,0 +1,133 @@
+package google
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/cloudkms/v1"
+)
+
+// The fields a secret version's payload can be read from.
+var secretManagerSecretVersionPayloadSources = []string{"secret_data", "secret_data_file", "secret_data_kms_ciphertext"}
+
+// secretManagerSecretVersionPayloadCrc32c returns the CRC32C checksum of a
+// payload, formatted the way the API returns dataCrc32c.
+func secretManagerSecretVersionPayloadCrc32c(data []byte) string {
+	return strconv.FormatUint(uint64(crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli))), 10)
+}
+
+// secretManagerSecretVersionLocalPayload returns the payload configured
+// through secret_data or secret_data_file. ok is false when the payload comes
+// from KMS ciphertext, so it can't be known without decrypting it.
+func secretManagerSecretVersionLocalPayload(d interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+}) (data []byte, ok bool, err error) {
+	if v, set := d.GetOk("secret_data"); set {
+		if d.Get("is_secret_data_base64").(bool) {
+			data, err = base64.StdEncoding.DecodeString(v.(string))
+			if err != nil {
+				return nil, false, fmt.Errorf("Error decoding secret_data, is_secret_data_base64 is set but it isn't valid base64: %s", err)
+			}
+			return data, true, nil
+		}
+		return []byte(v.(string)), true, nil
+	}
+
+	if v, set := d.GetOk("secret_data_file"); set {
+		data, err = ioutil.ReadFile(v.(string))
+		if err != nil {
+			return nil, false, fmt.Errorf("Error reading secret_data_file: %s", err)
+		}
+		return data, true, nil
+	}
+
+	return nil, false, nil
+}
+
+// secretManagerSecretVersionPayload returns the payload of the secret version,
+// decrypting secret_data_kms_ciphertext with Cloud KMS when it's set.
+func secretManagerSecretVersionPayload(d TerraformResourceData, config *Config) ([]byte, error) {
+	data, ok, err := secretManagerSecretVersionLocalPayload(d)
+	if err != nil || ok {
+		return data, err
+	}
+
+	ciphertext, ok := d.GetOk("secret_data_kms_ciphertext")
+	if !ok {
+		return nil, fmt.Errorf("one of %v must be set", secretManagerSecretVersionPayloadSources)
+	}
+
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	cryptoKeyId, err := parseKmsCryptoKeyId(d.Get("secret_data_kms_crypto_key").(string), config)
+	if err != nil {
+		return nil, err
+	}
+
+	decryptResponse, err := config.NewKmsClient(userAgent).Projects.Locations.KeyRings.CryptoKeys.Decrypt(cryptoKeyId.cryptoKeyId(), &cloudkms.DecryptRequest{
+		Ciphertext: ciphertext.(string),
+	}).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Error decrypting secret_data_kms_ciphertext: %s", err)
+	}
+
+	data, err = base64.StdEncoding.DecodeString(decryptResponse.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("Error decoding base64 response: %s", err)
+	}
+	return data, nil
+}
+
+// resourceSecretManagerSecretVersionPayloadCustomizeDiff plans the checksum of
+// payloads known locally, so that a changed secret_data_file replaces the
+// version even though its path stays the same.
+func resourceSecretManagerSecretVersionPayloadCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range append(secretManagerSecretVersionPayloadSources, "is_secret_data_base64") {
+		if !d.NewValueKnown(k) {
+			return d.SetNewComputed("payload_crc32c")
+		}
+	}
+
+	data, ok, err := secretManagerSecretVersionLocalPayload(d)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if d.Id() == "" || d.HasChange("secret_data_kms_ciphertext") {
+			return d.SetNewComputed("payload_crc32c")
+		}
+		return nil
+	}
+
+	crc := secretManagerSecretVersionPayloadCrc32c(data)
+	old := d.Get("payload_crc32c").(string)
+	if old == crc {
+		return nil
+	}
+	if err := d.SetNew("payload_crc32c", crc); err != nil {
+		return err
+	}
+	// Disabled versions can't be accessed, so their checksum may not be known
+	if d.Id() != "" && old != "" {
+		return d.ForceNew("payload_crc32c")
+	}
+	return nil
+}
+
+func secretManagerSecretVersionHasPayloadSource(d TerraformResourceData) bool {
+	for _, k := range secretManagerSecretVersionPayloadSources {
+		if _, ok := d.GetOk(k); ok {
+			return true
+		}
+	}
+	return false
+}
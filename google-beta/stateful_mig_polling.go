@@ -147,3 +147,99 @@ func PollCheckInstanceConfigDeleted(resp map[string]interface{}, respErr error)
 	}
 	return ErrorPollResult(fmt.Errorf("Expected PerInstanceConfig to be deleting but status is: %s", status))
 }
+
+// Schema shared by the internal_ip and external_ip fields of the preserved state of
+// PerInstanceConfig and RegionPerInstanceConfig
+func computePreservedStateNetworkIpSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"interface_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The network interface the preserved IP is attached to, e.g. "nic0".`,
+			},
+			"auto_delete": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEnum([]string{"NEVER", "ON_PERMANENT_INSTANCE_DELETION", ""}),
+				Description: `These stateful IPs will never be released during autohealing, update or VM instance recreate operations.
+This flag is used to configure if the IP reservation should be deleted after it is no longer used by the group,
+e.g. when the given instance or the whole group is deleted. Default value: "NEVER" Possible values: ["NEVER", "ON_PERMANENT_INSTANCE_DELETION"]`,
+				Default: "NEVER",
+			},
+			"ip_address": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: `Ip address representation`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: compareSelfLinkRelativePaths,
+							Description:      `The URL of the reservation for this IP address, in the format 'projects/project-id/regions/region/addresses/address-name'.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Preserved IPs are keyed by network interface name in the API, and are represented
+// as a set of objects with an interface_name in the schema.
+func flattenComputePreservedStateNetworkIps(v interface{}) interface{} {
+	if v == nil {
+		return v
+	}
+	ips := v.(map[string]interface{})
+	transformed := make([]interface{}, 0, len(ips))
+	for interfaceName, raw := range ips {
+		ipObj := raw.(map[string]interface{})
+		ip := map[string]interface{}{
+			"interface_name": interfaceName,
+			"auto_delete":    ipObj["autoDelete"],
+		}
+		if ipAddress, ok := ipObj["ipAddress"].(map[string]interface{}); ok {
+			address, _ := ipAddress["address"].(string)
+			if relative, err := getRelativePath(address); err == nil {
+				address = relative
+			}
+			ip["ip_address"] = []interface{}{
+				map[string]interface{}{
+					"address": address,
+				},
+			}
+		}
+		transformed = append(transformed, ip)
+	}
+	return transformed
+}
+
+func expandComputePreservedStateNetworkIps(v interface{}) (interface{}, error) {
+	if v == nil {
+		return map[string]interface{}{}, nil
+	}
+	l := v.(*schema.Set).List()
+	req := make(map[string]interface{})
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		ipObj := make(map[string]interface{})
+		if autoDelete := original["auto_delete"].(string); autoDelete != "" {
+			ipObj["autoDelete"] = autoDelete
+		}
+		if ipAddress := original["ip_address"].([]interface{}); len(ipAddress) > 0 && ipAddress[0] != nil {
+			if address := ipAddress[0].(map[string]interface{})["address"].(string); address != "" {
+				ipObj["ipAddress"] = map[string]interface{}{
+					"address": address,
+				}
+			}
+		}
+		req[original["interface_name"].(string)] = ipObj
+	}
+	return req, nil
+}
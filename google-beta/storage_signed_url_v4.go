@@ -0,0 +1,320 @@
+package google
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	iamcredentials "google.golang.org/api/iamcredentials/v1"
+)
+
+const (
+	gcsHost                 = "storage.googleapis.com"
+	storageV4Algorithm      = "GOOG4-RSA-SHA256"
+	storageV4DateFormat     = "20060102"
+	storageV4DateTimeFormat = "20060102T150405Z"
+	// V4 signatures can't be valid for more than 7 days.
+	storageV4MaxExpiry = 7 * 24 * time.Hour
+)
+
+// StorageSigner signs content with RSA-SHA256 on behalf of a service account.
+type StorageSigner struct {
+	Email string
+	Sign  func([]byte) ([]byte, error)
+}
+
+// loadStorageSigner returns a signer using the private key of the credentials
+// found by loadJwtConfig. When no private key is available, e.g. when using
+// application default credentials or service account impersonation, the
+// content is signed with the IAM Credentials signBlob API instead.
+func loadStorageSigner(d *schema.ResourceData, config *Config) (*StorageSigner, error) {
+	jwtConfig, err := loadJwtConfig(d, config)
+	if err == nil {
+		return &StorageSigner{
+			Email: jwtConfig.Email,
+			Sign: func(b []byte) ([]byte, error) {
+				return SignString(b, jwtConfig)
+			},
+		}, nil
+	}
+	if _, ok := d.GetOk("credentials"); ok {
+		return nil, err
+	}
+	log.Printf("[DEBUG] No private key available to sign with (%s), using the IAM signBlob API", err)
+
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	email := d.Get("service_account_email").(string)
+	if email == "" {
+		email = config.ImpersonateServiceAccount
+	}
+	if email == "" {
+		email, err = GetCurrentUserEmail(config, userAgent)
+		if err != nil {
+			return nil, fmt.Errorf("Error determining the service account to sign with, set service_account_email: %s", err)
+		}
+	}
+
+	return &StorageSigner{
+		Email: email,
+		Sign: func(b []byte) ([]byte, error) {
+			name := fmt.Sprintf("projects/-/serviceAccounts/%s", email)
+			req := &iamcredentials.SignBlobRequest{
+				Payload: base64.StdEncoding.EncodeToString(b),
+			}
+			res, err := config.NewIamCredentialsClient(userAgent).Projects.ServiceAccounts.SignBlob(name, req).Do()
+			if err != nil {
+				return nil, fmt.Errorf("Error signing with service account %q: %s", email, err)
+			}
+			return base64.StdEncoding.DecodeString(res.SignedBlob)
+		},
+	}, nil
+}
+
+// storageV4Host returns the host requests are sent to, either path style
+// (storage.googleapis.com/bucket) or virtual-hosted style
+// (bucket.storage.googleapis.com).
+func storageV4Host(bucket string, virtualHostedStyle bool) string {
+	if virtualHostedStyle {
+		return fmt.Sprintf("%s.%s", bucket, gcsHost)
+	}
+	return gcsHost
+}
+
+// storageV4EscapePath percent-encodes each segment of an object name.
+func storageV4EscapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		segments[i] = strings.Replace(url.QueryEscape(s), "+", "%20", -1)
+	}
+	return strings.Join(segments, "/")
+}
+
+func storageV4Credential(email string, t time.Time) string {
+	return fmt.Sprintf("%s/%s/auto/storage/goog4_request", email, t.Format(storageV4DateFormat))
+}
+
+// UrlDataV4 stores the values required to create a V4 Signed Url:
+// https://cloud.google.com/storage/docs/access-control/signed-urls#types
+type UrlDataV4 struct {
+	Signer             *StorageSigner
+	Bucket             string
+	Object             string
+	HttpMethod         string
+	ContentMd5         string
+	ContentType        string
+	HttpHeaders        map[string]string
+	VirtualHostedStyle bool
+	Time               time.Time
+	Expires            time.Duration
+}
+
+func (u *UrlDataV4) path() string {
+	if u.VirtualHostedStyle {
+		return "/" + storageV4EscapePath(u.Object)
+	}
+	return fmt.Sprintf("/%s/%s", u.Bucket, storageV4EscapePath(u.Object))
+}
+
+// headers returns the canonical (lowercased, trimmed) headers the client must
+// send, including the host header.
+func (u *UrlDataV4) headers() map[string]string {
+	headers := map[string]string{
+		"host": storageV4Host(u.Bucket, u.VirtualHostedStyle),
+	}
+	if u.ContentMd5 != "" {
+		headers["content-md5"] = u.ContentMd5
+	}
+	if u.ContentType != "" {
+		headers["content-type"] = u.ContentType
+	}
+	for k, v := range u.HttpHeaders {
+		headers[strings.ToLower(strings.TrimSpace(k))] = strings.Join(strings.Fields(v), " ")
+	}
+	return headers
+}
+
+func (u *UrlDataV4) signedHeaderNames() []string {
+	var names []string
+	for k := range u.headers() {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (u *UrlDataV4) queryParameters() url.Values {
+	q := url.Values{}
+	q.Set("X-Goog-Algorithm", storageV4Algorithm)
+	q.Set("X-Goog-Credential", storageV4Credential(u.Signer.Email, u.Time))
+	q.Set("X-Goog-Date", u.Time.Format(storageV4DateTimeFormat))
+	q.Set("X-Goog-Expires", fmt.Sprintf("%d", int64(u.Expires.Seconds())))
+	q.Set("X-Goog-SignedHeaders", strings.Join(u.signedHeaderNames(), ";"))
+	return q
+}
+
+// CanonicalRequest returns the canonical form of the request, in which the
+// signing parameters are sent as query parameters:
+// https://cloud.google.com/storage/docs/authentication/canonical-requests
+func (u *UrlDataV4) CanonicalRequest() string {
+	var buf bytes.Buffer
+
+	buf.WriteString(u.HttpMethod)
+	buf.WriteString("\n")
+	buf.WriteString(u.path())
+	buf.WriteString("\n")
+	buf.WriteString(strings.Replace(u.queryParameters().Encode(), "+", "%20", -1))
+	buf.WriteString("\n")
+
+	headers := u.headers()
+	names := u.signedHeaderNames()
+	for _, k := range names {
+		buf.WriteString(fmt.Sprintf("%s:%s\n", k, headers[k]))
+	}
+	buf.WriteString("\n")
+	buf.WriteString(strings.Join(names, ";"))
+	buf.WriteString("\n")
+	buf.WriteString("UNSIGNED-PAYLOAD")
+
+	return buf.String()
+}
+
+// SigningString returns the string to sign for the canonical request.
+func (u *UrlDataV4) SigningString() []byte {
+	hash := sha256.Sum256([]byte(u.CanonicalRequest()))
+	return []byte(strings.Join([]string{
+		storageV4Algorithm,
+		u.Time.Format(storageV4DateTimeFormat),
+		fmt.Sprintf("%s/auto/storage/goog4_request", u.Time.Format(storageV4DateFormat)),
+		hex.EncodeToString(hash[:]),
+	}, "\n"))
+}
+
+// EncodedSignature returns the hex encoded signature of SigningString()
+func (u *UrlDataV4) EncodedSignature() (string, error) {
+	if u.Expires > storageV4MaxExpiry {
+		return "", fmt.Errorf("V4 signed URLs can't be valid for more than %s, got %s", storageV4MaxExpiry, u.Expires)
+	}
+	signature, err := u.Signer.Sign(u.SigningString())
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(signature), nil
+}
+
+// SignedUrl constructs the final signed URL a client can use to access the storage object
+func (u *UrlDataV4) SignedUrl() (string, error) {
+	signature, err := u.EncodedSignature()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("https://%s%s?%s&X-Goog-Signature=%s",
+		storageV4Host(u.Bucket, u.VirtualHostedStyle),
+		u.path(),
+		strings.Replace(u.queryParameters().Encode(), "+", "%20", -1),
+		signature), nil
+}
+
+// PostPolicyV4 stores the values required to create a V4 signed POST policy:
+// https://cloud.google.com/storage/docs/authentication/signatures#policy-document
+type PostPolicyV4 struct {
+	Signer             *StorageSigner
+	Bucket             string
+	Object             string
+	Fields             map[string]string
+	ContentLengthMin   int
+	ContentLengthMax   int
+	VirtualHostedStyle bool
+	Time               time.Time
+	Expires            time.Duration
+}
+
+// Url returns the URL the form is posted to.
+func (p *PostPolicyV4) Url() string {
+	if p.VirtualHostedStyle {
+		return fmt.Sprintf("https://%s/", storageV4Host(p.Bucket, true))
+	}
+	return fmt.Sprintf("https://%s/%s/", gcsHost, p.Bucket)
+}
+
+// formFields returns the fields of the form other than the policy and its
+// signature, all of which are exact match conditions of the policy.
+func (p *PostPolicyV4) formFields() map[string]string {
+	fields := map[string]string{
+		"key":               p.Object,
+		"x-goog-algorithm":  storageV4Algorithm,
+		"x-goog-credential": storageV4Credential(p.Signer.Email, p.Time),
+		"x-goog-date":       p.Time.Format(storageV4DateTimeFormat),
+	}
+	for k, v := range p.Fields {
+		fields[k] = v
+	}
+	return fields
+}
+
+// Policy returns the base64 encoded policy document, which is the string
+// that is signed.
+func (p *PostPolicyV4) Policy() (string, error) {
+	fields := p.formFields()
+	var names []string
+	for k := range fields {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	conditions := []interface{}{
+		map[string]string{"bucket": p.Bucket},
+	}
+	for _, k := range names {
+		conditions = append(conditions, map[string]string{k: fields[k]})
+	}
+	if p.ContentLengthMax > 0 {
+		conditions = append(conditions, []interface{}{"content-length-range", p.ContentLengthMin, p.ContentLengthMax})
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	err := enc.Encode(map[string]interface{}{
+		"conditions": conditions,
+		"expiration": p.Time.Add(p.Expires).UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(bytes.TrimSpace(buf.Bytes())), nil
+}
+
+// SignedFields returns every field the form must send alongside the file,
+// including the policy and its signature.
+func (p *PostPolicyV4) SignedFields() (map[string]string, error) {
+	if p.Expires > storageV4MaxExpiry {
+		return nil, fmt.Errorf("V4 POST policies can't be valid for more than %s, got %s", storageV4MaxExpiry, p.Expires)
+	}
+	policy, err := p.Policy()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := p.Signer.Sign([]byte(policy))
+	if err != nil {
+		return nil, err
+	}
+
+	fields := p.formFields()
+	fields["policy"] = policy
+	fields["x-goog-signature"] = hex.EncodeToString(signature)
+	return fields, nil
+}
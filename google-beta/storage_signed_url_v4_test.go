@@ -0,0 +1,203 @@
+package google
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2/google"
+)
+
+func testStorageSigner(t *testing.T) (*StorageSigner, *rsa.PublicKey) {
+	cfg, err := google.JWTConfigFromJSON([]byte(fakeCredentials), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk, err := parsePrivateKey(cfg.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &StorageSigner{
+		Email: cfg.Email,
+		Sign: func(b []byte) ([]byte, error) {
+			return SignString(b, cfg)
+		},
+	}, &pk.PublicKey
+}
+
+func verifyStorageV4Signature(t *testing.T, key *rsa.PublicKey, signed []byte, signature string) {
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256(signed)
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], sig); err != nil {
+		t.Errorf("signature doesn't match the signed content: %s", err)
+	}
+}
+
+func TestUrlDataV4_CanonicalRequest(t *testing.T) {
+	signer, _ := testStorageSigner(t)
+
+	cases := map[string]struct {
+		UrlData  UrlDataV4
+		Expected string
+	}{
+		"path style": {
+			UrlData: UrlDataV4{
+				Bucket:     "tf-test-bucket",
+				Object:     "path/to/my file.txt",
+				HttpMethod: "GET",
+			},
+			Expected: `GET
+/tf-test-bucket/path/to/my%20file.txt
+X-Goog-Algorithm=GOOG4-RSA-SHA256&X-Goog-Credential=user%40gcp-project.iam.gserviceaccount.com%2F20160812%2Fauto%2Fstorage%2Fgoog4_request&X-Goog-Date=20160812T020330Z&X-Goog-Expires=3600&X-Goog-SignedHeaders=host
+host:storage.googleapis.com
+
+host
+UNSIGNED-PAYLOAD`,
+		},
+		"virtual hosted style with headers": {
+			UrlData: UrlDataV4{
+				Bucket:             "tf-test-bucket",
+				Object:             "file",
+				HttpMethod:         "PUT",
+				ContentType:        "text/plain",
+				HttpHeaders:        map[string]string{"X-Goog-Meta-Owner": "  some   owner "},
+				VirtualHostedStyle: true,
+			},
+			Expected: `PUT
+/file
+X-Goog-Algorithm=GOOG4-RSA-SHA256&X-Goog-Credential=user%40gcp-project.iam.gserviceaccount.com%2F20160812%2Fauto%2Fstorage%2Fgoog4_request&X-Goog-Date=20160812T020330Z&X-Goog-Expires=3600&X-Goog-SignedHeaders=content-type%3Bhost%3Bx-goog-meta-owner
+content-type:text/plain
+host:tf-test-bucket.storage.googleapis.com
+x-goog-meta-owner:some owner
+
+content-type;host;x-goog-meta-owner
+UNSIGNED-PAYLOAD`,
+		},
+	}
+
+	for tn, tc := range cases {
+		u := tc.UrlData
+		u.Signer = signer
+		u.Time = time.Unix(testUrlExpires, 0).UTC()
+		u.Expires = time.Hour
+		if got := u.CanonicalRequest(); got != tc.Expected {
+			t.Errorf("bad: %s, expected canonical request:\n%s\ngot:\n%s", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestUrlDataV4_SignedUrl(t *testing.T) {
+	signer, key := testStorageSigner(t)
+
+	u := &UrlDataV4{
+		Signer:     signer,
+		Bucket:     "tf-test-bucket",
+		Object:     "path/to/file",
+		HttpMethod: "GET",
+		Time:       time.Unix(testUrlExpires, 0).UTC(),
+		Expires:    time.Hour,
+	}
+	signedUrl, err := u.SignedUrl()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := url.Parse(signedUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Host != "storage.googleapis.com" || parsed.Path != "/tf-test-bucket/path/to/file" {
+		t.Errorf("unexpected signed URL %s", signedUrl)
+	}
+	if got := parsed.Query().Get("X-Goog-Expires"); got != "3600" {
+		t.Errorf("expected X-Goog-Expires to be 3600, got %q", got)
+	}
+	verifyStorageV4Signature(t, key, u.SigningString(), parsed.Query().Get("X-Goog-Signature"))
+
+	expectedSigningString := strings.Join([]string{
+		"GOOG4-RSA-SHA256",
+		"20160812T020330Z",
+		"20160812/auto/storage/goog4_request",
+	}, "\n")
+	if !strings.HasPrefix(string(u.SigningString()), expectedSigningString+"\n") {
+		t.Errorf("expected signing string to start with:\n%s\ngot:\n%s", expectedSigningString, u.SigningString())
+	}
+
+	u.Expires = 8 * 24 * time.Hour
+	if _, err := u.SignedUrl(); err == nil {
+		t.Errorf("expected an error for a V4 signed URL valid for more than 7 days")
+	}
+}
+
+func TestPostPolicyV4_SignedFields(t *testing.T) {
+	signer, key := testStorageSigner(t)
+
+	p := &PostPolicyV4{
+		Signer:           signer,
+		Bucket:           "tf-test-bucket",
+		Object:           "uploads/file.txt",
+		Fields:           map[string]string{"content-type": "text/plain"},
+		ContentLengthMin: 1,
+		ContentLengthMax: 1024,
+		Time:             time.Unix(testUrlExpires, 0).UTC(),
+		Expires:          time.Hour,
+	}
+	fields, err := p.SignedFields()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Url() != "https://storage.googleapis.com/tf-test-bucket/" {
+		t.Errorf("unexpected url %q", p.Url())
+	}
+	for k, v := range map[string]string{
+		"key":               "uploads/file.txt",
+		"content-type":      "text/plain",
+		"x-goog-algorithm":  "GOOG4-RSA-SHA256",
+		"x-goog-credential": "user@gcp-project.iam.gserviceaccount.com/20160812/auto/storage/goog4_request",
+		"x-goog-date":       "20160812T020330Z",
+	} {
+		if fields[k] != v {
+			t.Errorf("expected field %q to be %q, got %q", k, v, fields[k])
+		}
+	}
+	verifyStorageV4Signature(t, key, []byte(fields["policy"]), fields["x-goog-signature"])
+
+	decoded, err := base64.StdEncoding.DecodeString(fields["policy"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var policy struct {
+		Conditions []interface{} `json:"conditions"`
+		Expiration string        `json:"expiration"`
+	}
+	if err := json.Unmarshal(decoded, &policy); err != nil {
+		t.Fatal(err)
+	}
+	if policy.Expiration != "2016-08-12T03:03:30Z" {
+		t.Errorf("expected expiration 2016-08-12T03:03:30Z, got %q", policy.Expiration)
+	}
+	expectedConditions := []interface{}{
+		map[string]interface{}{"bucket": "tf-test-bucket"},
+		map[string]interface{}{"content-type": "text/plain"},
+		map[string]interface{}{"key": "uploads/file.txt"},
+		map[string]interface{}{"x-goog-algorithm": "GOOG4-RSA-SHA256"},
+		map[string]interface{}{"x-goog-credential": "user@gcp-project.iam.gserviceaccount.com/20160812/auto/storage/goog4_request"},
+		map[string]interface{}{"x-goog-date": "20160812T020330Z"},
+		[]interface{}{"content-length-range", float64(1), float64(1024)},
+	}
+	if !reflect.DeepEqual(policy.Conditions, expectedConditions) {
+		t.Errorf("expected conditions %v, got %v", expectedConditions, policy.Conditions)
+	}
+}
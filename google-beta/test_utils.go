@@ -109,6 +109,11 @@ func (d *ResourceDiffMock) ForceNew(key string) error {
 	return nil
 }
 
+func (d *ResourceDiffMock) SetNew(key string, value interface{}) error {
+	d.After[key] = value
+	return nil
+}
+
 func checkDataSourceStateMatchesResourceState(dataSourceName, resourceName string) func(*terraform.State) error {
 	return checkDataSourceStateMatchesResourceStateWithIgnores(dataSourceName, resourceName, map[string]struct{}{})
 }
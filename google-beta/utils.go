@@ -41,6 +41,7 @@ type TerraformResourceDiff interface {
 	GetOk(string) (interface{}, bool)
 	Clear(string) error
 	ForceNew(string) error
+	SetNew(string, interface{}) error
 }
 
 // getRegionFromZone returns the region from a zone for Google cloud.